@@ -0,0 +1,21 @@
+package main
+
+import (
+	"fmt"
+	"goweather/internal/cache"
+	"os"
+)
+
+// runCache implements "weather cache clear": it removes cached responses,
+// forecasts, locations, climate normals and discharge statistics.
+func runCache(args []string) {
+	if len(args) != 1 || args[0] != "clear" {
+		fmt.Fprintln(os.Stderr, "Usage: weather cache clear")
+		os.Exit(2)
+	}
+	if err := cache.Clear(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: Unable to clear the cache: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Println("Cache cleared")
+}
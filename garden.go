@@ -0,0 +1,37 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"goweather/internal/display"
+	"goweather/internal/garden"
+	"os"
+)
+
+// gardenPastDays is the number of past days that count towards the soil's
+// water deficit.
+const gardenPastDays = 3
+
+// runGarden implements "weather garden": soil temperature and moisture, the
+// daily water balance (precipitation minus reference evapotranspiration) and
+// whether to water today.
+func runGarden(args []string) {
+	fs := flag.NewFlagSet("garden", flag.ExitOnError)
+	sub := newSubcommand(fs)
+	imperial := fs.Bool("imperial", false, "Use imperial units (°F, in)")
+	fs.Parse(args)
+
+	loc, client, ctx, cancel := sub.resolve()
+	defer cancel()
+
+	data, err := client.FetchGarden(ctx, loc.Latitude, loc.Longitude, gardenPastDays, *sub.days)
+	if err != nil {
+		exitIfInterrupted(ctx)
+		fmt.Fprintf(os.Stderr, "Error: Unable to fetch garden data: %v\n", err)
+		os.Exit(1)
+	}
+
+	balance := garden.WaterBalance(data.Daily, loc.Latitude, data.Elevation)
+	advice := garden.Recommend(balance, data.PastDays, data.Soil)
+	fmt.Print(display.RenderGardenCard(locationName(loc), data, balance, advice, *imperial))
+}
@@ -0,0 +1,212 @@
+// Package cache stores HTTP responses on disk so that repeated invocations
+// can skip the network while the data is still fresh, and keeps the last
+// forecast per location for offline use.
+package cache
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"goweather/internal/weather"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Dir is the cache root: responses are kept in its http and forecasts in its
+// forecast subdirectory, and other packages keep their entries below it (see
+// Path). Defaults to the user cache directory ($XDG_CACHE_HOME/weather on Linux).
+var Dir = defaultDir()
+
+// defaultTTL applies to forecasts and everything not listed in rules.
+const defaultTTL = 15 * time.Minute
+
+// rules assigns cache lifetimes by host and path prefix; the first match
+// wins. Static or historical data keeps for weeks, forecasts for minutes, and
+// the 15-minute nowcast for one, since its outlook counts from the response.
+var rules = []struct {
+	host  string
+	path  string // path prefix, empty for any
+	query string // required query parameter, empty for any
+	ttl   time.Duration
+}{
+	{"geocoding-api.open-meteo.com", "", "", 30 * 24 * time.Hour},
+	{"api.open-meteo.com", "/v1/elevation", "", 30 * 24 * time.Hour},
+	{"api.open-meteo.com", "/v1/forecast", "minutely_15", time.Minute},
+	{"flood-api.open-meteo.com", "", "start_date", 30 * 24 * time.Hour},
+	{"flood-api.open-meteo.com", "", "", 3 * time.Hour},
+	{"archive-api.open-meteo.com", "", "", 24 * time.Hour},
+	{"ip-api.com", "", "", time.Hour},
+}
+
+// TTL returns how long a response for u stays fresh.
+func TTL(u *url.URL) time.Duration {
+	host := strings.ToLower(u.Hostname())
+	for _, r := range rules {
+		if host != r.host || !strings.HasPrefix(u.Path, r.path) {
+			continue
+		}
+		if r.query != "" && !u.Query().Has(r.query) {
+			continue
+		}
+		return r.ttl
+	}
+	return defaultTTL
+}
+
+// Transport is an http.RoundTripper that answers GET requests from the
+// cache while the stored response is fresh, and stores successful responses
+// from the underlying transport. Other requests pass through.
+//
+// An Offline transport never uses the network: it serves cached responses
+// regardless of their age and fails for everything else.
+type Transport struct {
+	Base    http.RoundTripper
+	Offline bool
+}
+
+// NewTransport returns a caching transport around base, or around
+// http.DefaultTransport if base is nil.
+func NewTransport(base http.RoundTripper) *Transport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &Transport{Base: base}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.Offline {
+		if req.Method == http.MethodGet && Dir != "" {
+			if resp, err := load(responsePath(req.URL), req, -1); err == nil {
+				return resp, nil
+			}
+		}
+		return nil, fmt.Errorf("offline: no cached response from %s", req.URL.Host)
+	}
+	if req.Method != http.MethodGet || Dir == "" {
+		return t.Base.RoundTrip(req)
+	}
+
+	path := responsePath(req.URL)
+	if resp, err := load(path, req, TTL(req.URL)); err == nil {
+		return resp, nil
+	}
+
+	resp, err := t.Base.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		return resp, err
+	}
+
+	// DumpResponse buffers the body and restores it for the caller
+	b, err := httputil.DumpResponse(resp, true)
+	if err != nil {
+		return nil, err
+	}
+	_ = WriteFile(path, b)
+	return resp, nil
+}
+
+// Key returns the cache file name for a request URL. The URL is normalized
+// first: the host is lowercased and query parameters are sorted, so the same
+// request always maps to the same entry.
+func Key(u *url.URL) string {
+	n := *u
+	n.Host = strings.ToLower(n.Host)
+	n.RawQuery = n.Query().Encode()
+	n.Fragment = ""
+	sum := sha256.Sum256([]byte(n.String()))
+	return hex.EncodeToString(sum[:])
+}
+
+// responsePath returns the cache file for a request URL.
+func responsePath(u *url.URL) string {
+	return Path("http", Key(u))
+}
+
+// snapshot is a forecast as saved for offline use.
+type snapshot struct {
+	Time time.Time            `json:"time"`
+	Data *weather.WeatherData `json:"data"`
+}
+
+// SaveWeather keeps data as the latest forecast for a location, in the given
+// unit system. Only the forecast is kept, see withoutExtras.
+func SaveWeather(lat, lon float64, imperial bool, data *weather.WeatherData) error {
+	return WriteJSON(forecastPath(lat, lon, imperial), snapshot{Time: time.Now(), Data: withoutExtras(data)})
+}
+
+// LoadWeather returns the latest forecast saved for a location and when it
+// was fetched.
+func LoadWeather(lat, lon float64, imperial bool) (*weather.WeatherData, time.Time, error) {
+	var s snapshot
+	if err := ReadJSON(forecastPath(lat, lon, imperial), &s); err != nil {
+		return nil, time.Time{}, err
+	}
+	if s.Data == nil {
+		return nil, time.Time{}, fmt.Errorf("empty forecast snapshot")
+	}
+	return withoutExtras(s.Data), s.Time, nil
+}
+
+// withoutExtras returns a copy of data without the views and sections fetched
+// on request, such as air quality or the nowcast. Shown from an old snapshot
+// they would pass for current, and a stale card is only the daily forecast.
+func withoutExtras(data *weather.WeatherData) *weather.WeatherData {
+	forecast := *data
+	forecast.Hourly = nil
+	forecast.AirQuality = nil
+	forecast.Pollen = nil
+	forecast.Marine = nil
+	forecast.Snow = nil
+	forecast.Nowcast = nil
+	return &forecast
+}
+
+// forecastPath returns the snapshot file for a location and unit system.
+func forecastPath(lat, lon float64, imperial bool) string {
+	system := "metric"
+	if imperial {
+		system = "imperial"
+	}
+	return Path("forecast", CoordKey(lat, lon)+"_"+system+".json")
+}
+
+// Clear removes everything under the cache root.
+func Clear() error {
+	if Dir == "" {
+		return nil
+	}
+	return os.RemoveAll(Dir)
+}
+
+// load returns the cached response at path if it is younger than ttl, or of
+// any age for a negative ttl.
+func load(path string, req *http.Request, ttl time.Duration) (*http.Response, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if ttl >= 0 && time.Since(info.ModTime()) > ttl {
+		return nil, os.ErrNotExist
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return http.ReadResponse(bufio.NewReader(bytes.NewReader(b)), req)
+}
+
+func defaultDir() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "weather")
+}
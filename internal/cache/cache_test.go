@@ -0,0 +1,353 @@
+package cache
+
+import (
+	"compress/gzip"
+	"fmt"
+	"goweather/internal/weather"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// useTempDir points the cache at a fresh directory for the test.
+func useTempDir(t *testing.T) {
+	t.Helper()
+	old := Dir
+	Dir = t.TempDir()
+	t.Cleanup(func() { Dir = old })
+}
+
+// get fetches url through a caching client and returns the body.
+func get(t *testing.T, client *http.Client, url string) (int, string) {
+	t.Helper()
+	resp, err := client.Get(url)
+	if err != nil {
+		t.Fatalf("GET %s: %v", url, err)
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("reading %s: %v", url, err)
+	}
+	return resp.StatusCode, string(b)
+}
+
+func TestTTL(t *testing.T) {
+	tests := []struct {
+		url  string
+		want time.Duration
+	}{
+		{"https://geocoding-api.open-meteo.com/v1/search?name=Berlin", 30 * 24 * time.Hour},
+		{"https://api.open-meteo.com/v1/elevation?latitude=52.52&longitude=13.41", 30 * 24 * time.Hour},
+		{"https://api.open-meteo.com/v1/forecast?latitude=52.52&longitude=13.41", 15 * time.Minute},
+		{"https://api.open-meteo.com/v1/forecast?latitude=52.52&longitude=13.41&minutely_15=precipitation", time.Minute},
+		{"https://flood-api.open-meteo.com/v1/flood?daily=river_discharge&start_date=1991-01-01", 30 * 24 * time.Hour},
+		{"https://flood-api.open-meteo.com/v1/flood?daily=river_discharge&forecast_days=7", 3 * time.Hour},
+		{"https://archive-api.open-meteo.com/v1/archive?start_date=2026-01-01", 24 * time.Hour},
+		{"http://ip-api.com/json/", time.Hour},
+		{"https://api.met.no/weatherapi/locationforecast/2.0/compact", 15 * time.Minute},
+	}
+	for _, tt := range tests {
+		u, _ := url.Parse(tt.url)
+		if got := TTL(u); got != tt.want {
+			t.Errorf("TTL(%s) = %v, want %v", tt.url, got, tt.want)
+		}
+	}
+}
+
+func TestKey(t *testing.T) {
+	parse := func(s string) *url.URL {
+		u, _ := url.Parse(s)
+		return u
+	}
+	a := Key(parse("https://api.open-meteo.com/v1/forecast?latitude=52.52&longitude=13.41"))
+	b := Key(parse("https://API.open-meteo.com/v1/forecast?longitude=13.41&latitude=52.52"))
+	c := Key(parse("https://api.open-meteo.com/v1/forecast?latitude=52.52&longitude=13.42"))
+	if a != b {
+		t.Error("keys should not depend on host case or parameter order")
+	}
+	if a == c {
+		t.Error("different requests should have different keys")
+	}
+}
+
+func TestTransport(t *testing.T) {
+	useTempDir(t)
+
+	var hits atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		if r.URL.Path == "/error" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"q":%q}`, r.URL.RawQuery)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: NewTransport(nil)}
+
+	status, body := get(t, client, server.URL+"/forecast?a=1&b=2")
+	if status != http.StatusOK || body != `{"q":"a=1&b=2"}` {
+		t.Fatalf("first response = %d %s", status, body)
+	}
+	// Same request with reordered parameters comes from the cache
+	_, body = get(t, client, server.URL+"/forecast?b=2&a=1")
+	if hits.Load() != 1 || body != `{"q":"a=1&b=2"}` {
+		t.Errorf("cached response: hits = %d, body %s, want 1 hit and the first body", hits.Load(), body)
+	}
+
+	get(t, client, server.URL+"/forecast?a=1&b=3")
+	if hits.Load() != 2 {
+		t.Errorf("hits = %d, want 2 for a different request", hits.Load())
+	}
+
+	// Errors are not cached
+	get(t, client, server.URL+"/error")
+	if status, _ := get(t, client, server.URL+"/error"); status != http.StatusInternalServerError || hits.Load() != 4 {
+		t.Errorf("error responses: status %d, hits = %d, want 500 and 4", status, hits.Load())
+	}
+
+	// Expired entries are refetched
+	u, _ := url.Parse(server.URL + "/forecast?a=1&b=2")
+	old := time.Now().Add(-time.Hour)
+	if err := os.Chtimes(responsePath(u), old, old); err != nil {
+		t.Fatal(err)
+	}
+	get(t, client, u.String())
+	if hits.Load() != 5 {
+		t.Errorf("hits = %d, want 5 after expiry", hits.Load())
+	}
+}
+
+func TestTransportCompressed(t *testing.T) {
+	useTempDir(t)
+
+	body := strings.Repeat(`{"temperature_2m":[1.5,2.5,3.5]}`, 1000)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Encoding", "gzip")
+		gz := gzip.NewWriter(w)
+		io.WriteString(gz, body)
+		gz.Close()
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: NewTransport(nil)}
+	for i := 0; i < 2; i++ {
+		if _, got := get(t, client, server.URL); got != body {
+			t.Fatalf("response %d: got %d bytes, want the decompressed %d", i, len(got), len(body))
+		}
+	}
+}
+
+func TestTransportConcurrent(t *testing.T) {
+	useTempDir(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, strings.Repeat("x", 64*1024))
+	}))
+	defer server.Close()
+
+	// Separate transports stand in for separate invocations
+	var wg sync.WaitGroup
+	errs := make(chan error, 20)
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := (&http.Client{Transport: NewTransport(nil)}).Get(server.URL)
+			if err != nil {
+				errs <- err
+				return
+			}
+			defer resp.Body.Close()
+			if b, _ := io.ReadAll(resp.Body); len(b) != 64*1024 {
+				errs <- fmt.Errorf("got %d bytes, want %d", len(b), 64*1024)
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Error(err)
+	}
+
+	// Only the final entry remains, no temporary files
+	entries, _ := os.ReadDir(filepath.Join(Dir, "http"))
+	if len(entries) != 1 {
+		t.Errorf("cache holds %d files, want 1", len(entries))
+	}
+}
+
+func TestTransportOffline(t *testing.T) {
+	useTempDir(t)
+
+	var hits atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		io.WriteString(w, "ok")
+	}))
+	defer server.Close()
+
+	get(t, &http.Client{Transport: NewTransport(nil)}, server.URL+"/search?name=Berlin")
+
+	// Offline, even an expired entry is served and nothing else is fetched
+	u, _ := url.Parse(server.URL + "/search?name=Berlin")
+	old := time.Now().AddDate(0, -6, 0)
+	if err := os.Chtimes(responsePath(u), old, old); err != nil {
+		t.Fatal(err)
+	}
+	offline := &http.Client{Transport: &Transport{Base: http.DefaultTransport, Offline: true}}
+	if _, body := get(t, offline, u.String()); body != "ok" {
+		t.Errorf("offline body = %q, want the cached one", body)
+	}
+	if _, err := offline.Get(server.URL + "/search?name=Paris"); err == nil {
+		t.Error("expected error for an uncached request offline, got nil")
+	}
+	if hits.Load() != 1 {
+		t.Errorf("hits = %d, want 1", hits.Load())
+	}
+}
+
+func TestSaveWeather(t *testing.T) {
+	useTempDir(t)
+
+	if _, _, err := LoadWeather(52.52, 13.41, false); err == nil {
+		t.Error("expected error without a saved forecast, got nil")
+	}
+
+	data := &weather.WeatherData{
+		Current:  weather.CurrentWeather{Temperature: -3.2, WeatherCode: 2},
+		Daily:    []weather.DailyForecast{{Date: "2026-02-14", TemperatureMax: 1.5}},
+		Timezone: "Europe/Berlin",
+	}
+	before := time.Now()
+	if err := SaveWeather(52.5200, 13.4050, false, data); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Nearby coordinates share the entry, the other unit system does not
+	got, asOf, err := LoadWeather(52.521, 13.402, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Current.Temperature != -3.2 || len(got.Daily) != 1 || got.Daily[0].TemperatureMax != 1.5 || got.Timezone != "Europe/Berlin" {
+		t.Errorf("loaded forecast = %+v, want the saved one", got)
+	}
+	if asOf.Before(before.Add(-time.Second)) || asOf.After(time.Now()) {
+		t.Errorf("as of = %v, want the time of saving", asOf)
+	}
+	if _, _, err := LoadWeather(52.52, 13.41, true); err == nil {
+		t.Error("expected error for the imperial forecast, got nil")
+	}
+}
+
+func TestSaveWeatherWithoutExtras(t *testing.T) {
+	useTempDir(t)
+
+	data := &weather.WeatherData{
+		Daily:      []weather.DailyForecast{{Date: "2026-02-14"}},
+		AirQuality: &weather.AirQuality{EuropeanAQI: 34},
+		Pollen:     &weather.PollenForecast{},
+		Nowcast:    &weather.Nowcast{},
+	}
+	if err := SaveWeather(52.52, 13.41, false, data); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got, _, err := LoadWeather(52.52, 13.41, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.AirQuality != nil || got.Pollen != nil || got.Nowcast != nil {
+		t.Errorf("loaded forecast = %+v, want no air quality, pollen or nowcast", got)
+	}
+	if data.AirQuality == nil {
+		t.Error("SaveWeather should leave the caller's data alone")
+	}
+}
+
+func TestClear(t *testing.T) {
+	useTempDir(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, "ok")
+	}))
+	defer server.Close()
+
+	get(t, &http.Client{Transport: NewTransport(nil)}, server.URL)
+	if err := Clear(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := os.Stat(Dir); !os.IsNotExist(err) {
+		t.Errorf("cache directory should be gone, got %v", err)
+	}
+	// Clearing an empty cache is fine
+	if err := Clear(); err != nil {
+		t.Errorf("unexpected error clearing an empty cache: %v", err)
+	}
+}
+
+func TestWriteJSON(t *testing.T) {
+	useTempDir(t)
+
+	type entry struct{ Name string }
+	path := Path("normals", CoordKey(52.5230, 13.4080)+".json")
+	if want := filepath.Join(Dir, "normals", "52.52_13.41.json"); path != want {
+		t.Errorf("path = %q, want %q", path, want)
+	}
+
+	var got entry
+	if err := ReadJSON(path, &got); err == nil {
+		t.Error("expected error for a missing entry, got nil")
+	}
+	if err := WriteJSON(path, entry{Name: "Berlin"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := ReadJSON(path, &got); err != nil || got.Name != "Berlin" {
+		t.Errorf("read %+v (%v), want the written entry", got, err)
+	}
+
+	// No temporary files are left behind
+	files, _ := os.ReadDir(filepath.Dir(path))
+	if len(files) != 1 {
+		t.Errorf("directory has %d files, want 1", len(files))
+	}
+
+	Dir = ""
+	if Path("location.json") != "" {
+		t.Error("path should be empty without a cache directory")
+	}
+	if err := WriteJSON(Path("location.json"), entry{}); err == nil {
+		t.Error("expected error without a cache directory, got nil")
+	}
+}
+
+func TestDayIndex(t *testing.T) {
+	tests := []struct {
+		date   string
+		want   int
+		wantOK bool
+	}{
+		{"2026-01-01", 0, true},
+		{"2024-02-29", 59, true},
+		{"2026-03-01", 60, true},
+		{"2025-12-31", 365, true},
+		{"2026-13-01", 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := DayIndex(tt.date)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("DayIndex(%q) = %d, %v, want %d, %v", tt.date, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package cache
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// errNoDir is returned when writing without a cache directory.
+var errNoDir = errors.New("no cache directory")
+
+// Path returns the file of a cache entry below Dir, or "" when there is no
+// cache directory.
+func Path(elem ...string) string {
+	if Dir == "" {
+		return ""
+	}
+	return filepath.Join(append([]string{Dir}, elem...)...)
+}
+
+// CoordKey returns the part of an entry name that identifies a location. The
+// coordinates are rounded to ~1 km so that nearby lookups share an entry.
+func CoordKey(lat, lon float64) string {
+	return fmt.Sprintf("%.2f_%.2f", lat, lon)
+}
+
+// WriteFile writes an entry via a temporary file in the same directory, so
+// that concurrent invocations never see a partial entry; the last writer
+// wins. Callers may ignore the error: an entry that could not be written is
+// only fetched or detected again next time.
+func WriteFile(path string, b []byte) error {
+	if path == "" {
+		return errNoDir
+	}
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(dir, ".entry-*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// WriteJSON writes v as a JSON entry, see WriteFile.
+func WriteJSON(path string, v interface{}) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return WriteFile(path, b)
+}
+
+// ReadJSON decodes the JSON entry at path into v.
+func ReadJSON(path string, v interface{}) error {
+	if path == "" {
+		return os.ErrNotExist
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
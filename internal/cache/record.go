@@ -0,0 +1,21 @@
+package cache
+
+import "time"
+
+// Reference period of the long-term records that climate normals and river
+// discharge statistics are computed from (WMO standard 1991-2020). Both are
+// downloaded once per location and kept in the cache.
+const (
+	RecordStart = "1991-01-01"
+	RecordEnd   = "2020-12-31"
+)
+
+// DayIndex maps a date (YYYY-MM-DD) to its calendar day 0-365, using a leap
+// year so that Feb 29 gets its own slot and the other days line up across years.
+func DayIndex(date string) (int, bool) {
+	t, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return 0, false
+	}
+	return time.Date(2000, t.Month(), t.Day(), 0, 0, 0, 0, time.UTC).YearDay() - 1, true
+}
@@ -0,0 +1,117 @@
+// Package climate computes climatological normals from the historical archive
+// and compares forecasts against them.
+package climate
+
+import (
+	"context"
+	"fmt"
+	"goweather/internal/cache"
+	"goweather/internal/units"
+	"goweather/internal/weather"
+)
+
+// smoothingDays is the half-width of the window (in calendar days) averaged
+// around each day to even out single-year noise.
+const smoothingDays = 7
+
+// Normals holds mean daily max/min temperatures (°C) for each calendar day,
+// indexed by cache.DayIndex.
+type Normals struct {
+	Latitude  float64      `json:"latitude"`
+	Longitude float64      `json:"longitude"`
+	Max       [366]float64 `json:"max"`
+	Min       [366]float64 `json:"min"`
+}
+
+// Get returns the normals for a location, computing them from the archive's
+// 1991-2020 record on first use and caching them on disk afterwards. The
+// archive client must return metric values.
+func Get(ctx context.Context, client *weather.ArchiveClient, lat, lon float64) (*Normals, error) {
+	path := cachePath(lat, lon)
+	var cached Normals
+	if err := cache.ReadJSON(path, &cached); err == nil {
+		return &cached, nil
+	}
+
+	days, err := client.FetchHistory(ctx, lat, lon, cache.RecordStart, cache.RecordEnd, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch climate record: %w", err)
+	}
+
+	n := Compute(days)
+	n.Latitude, n.Longitude = lat, lon
+
+	_ = cache.WriteJSON(path, n)
+
+	return n, nil
+}
+
+// Compute averages observed daily max/min temperatures per calendar day,
+// smoothed over a window of ±smoothingDays.
+func Compute(days []weather.DailyForecast) *Normals {
+	var sumMax, sumMin [366]float64
+	var count [366]int
+	for _, d := range days {
+		idx, ok := cache.DayIndex(d.Date)
+		if !ok {
+			continue
+		}
+		sumMax[idx] += d.TemperatureMax
+		sumMin[idx] += d.TemperatureMin
+		count[idx]++
+	}
+
+	n := &Normals{}
+	for i := range n.Max {
+		var sMax, sMin float64
+		var c int
+		for off := -smoothingDays; off <= smoothingDays; off++ {
+			j := (i + off + 366) % 366
+			sMax += sumMax[j]
+			sMin += sumMin[j]
+			c += count[j]
+		}
+		if c > 0 {
+			n.Max[i] = sMax / float64(c)
+			n.Min[i] = sMin / float64(c)
+		}
+	}
+	return n
+}
+
+// For returns the normal max/min temperatures (°C) for a date (YYYY-MM-DD).
+func (n *Normals) For(date string) (max, min float64, ok bool) {
+	idx, ok := cache.DayIndex(date)
+	if !ok {
+		return 0, 0, false
+	}
+	return n.Max[idx], n.Min[idx], true
+}
+
+// Apply fills the normal temperatures of each forecast day, converting to
+// Fahrenheit for imperial forecasts.
+func Apply(daily []weather.DailyForecast, n *Normals, imperial bool) {
+	for i := range daily {
+		max, min, ok := n.For(daily[i].Date)
+		if !ok {
+			continue
+		}
+		if imperial {
+			max, min = units.CelsiusToFahrenheit(max), units.CelsiusToFahrenheit(min)
+		}
+		daily[i].NormalMax = max
+		daily[i].NormalMin = min
+		daily[i].HasNormal = true
+	}
+}
+
+// Anomaly returns how much warmer (positive) or colder (negative) a day's
+// mean temperature is than its normal, in the forecast's units.
+func Anomaly(d weather.DailyForecast) float64 {
+	return (d.TemperatureMax+d.TemperatureMin)/2 - (d.NormalMax+d.NormalMin)/2
+}
+
+// cachePath returns the cache file of the normals for a location.
+func cachePath(lat, lon float64) string {
+	return cache.Path("normals", cache.CoordKey(lat, lon)+".json")
+}
@@ -0,0 +1,108 @@
+package climate
+
+import (
+	"context"
+	"goweather/internal/cache"
+	"goweather/internal/weather"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestComputeAveragesAcrossYears(t *testing.T) {
+	days := []weather.DailyForecast{
+		{Date: "2001-07-14", TemperatureMax: 24, TemperatureMin: 14},
+		{Date: "2002-07-14", TemperatureMax: 28, TemperatureMin: 16},
+	}
+
+	n := Compute(days)
+	max, min, ok := n.For("2026-07-14")
+	if !ok {
+		t.Fatal("For returned ok=false for a valid date")
+	}
+	if max != 26 {
+		t.Errorf("normal max = %f, want 26", max)
+	}
+	if min != 15 {
+		t.Errorf("normal min = %f, want 15", min)
+	}
+
+	// Neighbouring days inside the smoothing window share the observations
+	if max, _, _ := n.For("2026-07-20"); max != 26 {
+		t.Errorf("smoothed normal max = %f, want 26", max)
+	}
+}
+
+func TestComputeWrapsAroundYearEnd(t *testing.T) {
+	days := []weather.DailyForecast{
+		{Date: "2001-12-30", TemperatureMax: 2, TemperatureMin: -4},
+	}
+
+	n := Compute(days)
+	if max, _, _ := n.For("2026-01-02"); max != 2 {
+		t.Errorf("normal max for Jan 2 = %f, want 2 (from Dec 30)", max)
+	}
+}
+
+func TestApplyAndAnomaly(t *testing.T) {
+	n := &Normals{}
+	idx, _ := cache.DayIndex("2026-07-14")
+	n.Max[idx], n.Min[idx] = 25, 15
+
+	daily := []weather.DailyForecast{
+		{Date: "2026-07-14", TemperatureMax: 30, TemperatureMin: 18},
+		{Date: "not-a-date"},
+	}
+	Apply(daily, n, false)
+
+	if !daily[0].HasNormal {
+		t.Fatal("HasNormal should be set")
+	}
+	if got := Anomaly(daily[0]); got != 4 {
+		t.Errorf("anomaly = %f, want 4", got)
+	}
+	if daily[1].HasNormal {
+		t.Error("HasNormal should not be set for an invalid date")
+	}
+
+	imperial := []weather.DailyForecast{{Date: "2026-07-14"}}
+	Apply(imperial, n, true)
+	if imperial[0].NormalMax != 77 || imperial[0].NormalMin != 59 {
+		t.Errorf("imperial normals = %f/%f, want 77/59", imperial[0].NormalMax, imperial[0].NormalMin)
+	}
+}
+
+func TestGetCachesNormals(t *testing.T) {
+	old := cache.Dir
+	cache.Dir = t.TempDir()
+	defer func() { cache.Dir = old }()
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"daily":{"time":["2001-03-01","2002-03-01"],` +
+			`"temperature_2m_max":[8,10],"temperature_2m_min":[0,2]}}`))
+	}))
+	defer server.Close()
+
+	client := &weather.ArchiveClient{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+	}
+
+	for i := 0; i < 2; i++ {
+		n, err := Get(context.Background(), client, 52.52, 13.41)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if max, _, _ := n.For("2026-03-01"); math.Abs(max-9) > 1e-9 {
+			t.Errorf("normal max = %f, want 9", max)
+		}
+	}
+
+	if requests != 1 {
+		t.Errorf("archive requests = %d, want 1 (second call should hit the cache)", requests)
+	}
+}
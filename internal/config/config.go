@@ -0,0 +1,51 @@
+// Package config reads optional user settings from a JSON file in the user
+// config directory.
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"goweather/internal/solar"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// Path is the config file location. Defaults to the user config directory
+// ($XDG_CONFIG_HOME/weather/config.json on Linux).
+var Path = defaultPath()
+
+// Config holds the settings read from the config file. Sections that are not
+// present are nil.
+type Config struct {
+	Solar *solar.Panel `json:"solar"`
+}
+
+// Load reads the config file at Path. A missing file is not an error and
+// yields an empty Config.
+func Load() (*Config, error) {
+	var c Config
+	if Path == "" {
+		return &c, nil
+	}
+	b, err := os.ReadFile(Path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return &c, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+	if err := json.Unmarshal(b, &c); err != nil {
+		return nil, fmt.Errorf("invalid config file %s: %w", Path, err)
+	}
+	return &c, nil
+}
+
+func defaultPath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "weather", "config.json")
+}
@@ -0,0 +1,49 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadMissingFile(t *testing.T) {
+	Path = filepath.Join(t.TempDir(), "config.json")
+
+	c, err := Load()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if c.Solar != nil {
+		t.Errorf("solar = %+v, want nil without a config file", c.Solar)
+	}
+}
+
+func TestLoadSolar(t *testing.T) {
+	Path = filepath.Join(t.TempDir(), "config.json")
+	data := `{"solar": {"kwp": 6.5, "tilt": 35, "azimuth": 180, "losses": 14}}`
+	if err := os.WriteFile(Path, []byte(data), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := Load()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if c.Solar == nil {
+		t.Fatal("solar section should be loaded")
+	}
+	if c.Solar.KWp != 6.5 || c.Solar.Tilt != 35 || c.Solar.Azimuth != 180 || c.Solar.Losses != 14 {
+		t.Errorf("solar = %+v, want 6.5 kWp, 35°, 180°, 14%%", *c.Solar)
+	}
+}
+
+func TestLoadInvalid(t *testing.T) {
+	Path = filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(Path, []byte(`{"solar": `), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := Load(); err == nil {
+		t.Error("expected error for malformed config file, got nil")
+	}
+}
@@ -0,0 +1,114 @@
+// Package degreedays computes heating, cooling and growing degree days from
+// daily temperatures, for forecasts and observed ranges alike.
+package degreedays
+
+import (
+	"goweather/internal/weather"
+	"math"
+	"time"
+)
+
+// Bases configures the degree-day calculation. Temperatures are in the units
+// of the weather data (°C for metric, °F for imperial).
+type Bases struct {
+	Heating    float64 // HDD accumulate while the daily mean is below
+	Cooling    float64 // CDD accumulate while the daily mean is above
+	Growing    float64 // GDD base, the crop's minimum growth temperature
+	GrowingCap float64 // GDD cap, above which growth does not speed up
+}
+
+// DefaultBases returns the common bases for the unit system: 18°C/65°F for
+// heating and cooling, and the 10-30°C (50-86°F) method for growing degree
+// days used for corn and many vegetables.
+func DefaultBases(imperial bool) Bases {
+	if imperial {
+		return Bases{Heating: 65, Cooling: 65, Growing: 50, GrowingCap: 86}
+	}
+	return Bases{Heating: 18, Cooling: 18, Growing: 10, GrowingCap: 30}
+}
+
+// Day holds the degree days of one day.
+type Day struct {
+	Date string
+	HDD  float64
+	CDD  float64
+	GDD  float64
+}
+
+// Week holds degree-day totals over the days of one calendar week (Monday to
+// Sunday) present in the data; Start is the first of them.
+type Week struct {
+	Start string
+	Days  int
+	HDD   float64
+	CDD   float64
+	GDD   float64
+}
+
+// Compute returns the degree days of each day, based on the mean of the daily
+// maximum and minimum temperature. For GDD both are first clamped to the
+// range between the growing base and cap.
+func Compute(daily []weather.DailyForecast, b Bases) []Day {
+	days := make([]Day, len(daily))
+	for i, d := range daily {
+		mean := (d.TemperatureMax + d.TemperatureMin) / 2
+		hi := clamp(d.TemperatureMax, b.Growing, b.GrowingCap)
+		lo := clamp(d.TemperatureMin, b.Growing, b.GrowingCap)
+		days[i] = Day{
+			Date: d.Date,
+			HDD:  math.Max(b.Heating-mean, 0),
+			CDD:  math.Max(mean-b.Cooling, 0),
+			GDD:  (hi+lo)/2 - b.Growing,
+		}
+	}
+	return days
+}
+
+// Weekly sums the days per calendar week, in order.
+func Weekly(days []Day) []Week {
+	var weeks []Week
+	var current string
+	for _, d := range days {
+		monday := weekStart(d.Date)
+		if len(weeks) == 0 || monday != current {
+			weeks = append(weeks, Week{Start: d.Date})
+			current = monday
+		}
+		w := &weeks[len(weeks)-1]
+		w.Days++
+		w.HDD += d.HDD
+		w.CDD += d.CDD
+		w.GDD += d.GDD
+	}
+	return weeks
+}
+
+// Total sums all days.
+func Total(days []Day) Week {
+	var t Week
+	for _, d := range days {
+		if t.Days == 0 {
+			t.Start = d.Date
+		}
+		t.Days++
+		t.HDD += d.HDD
+		t.CDD += d.CDD
+		t.GDD += d.GDD
+	}
+	return t
+}
+
+// weekStart returns the Monday of the week containing date (YYYY-MM-DD), or
+// the date itself if it cannot be parsed.
+func weekStart(date string) string {
+	t, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return date
+	}
+	return t.AddDate(0, 0, -(int(t.Weekday())+6)%7).Format("2006-01-02")
+}
+
+// clamp limits v to the range [lo, hi].
+func clamp(v, lo, hi float64) float64 {
+	return math.Max(lo, math.Min(v, hi))
+}
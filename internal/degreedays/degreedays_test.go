@@ -0,0 +1,72 @@
+package degreedays
+
+import (
+	"goweather/internal/weather"
+	"math"
+	"testing"
+)
+
+func TestCompute(t *testing.T) {
+	tests := []struct {
+		name          string
+		max, min      float64
+		imperial      bool
+		hdd, cdd, gdd float64
+	}{
+		{"cold day", 8, 2, false, 13, 0, 0},
+		{"mild day", 16, 8, false, 6, 0, 3},
+		{"hot day", 34, 22, false, 0, 10, 16},
+		// The classic 86/50 example: 95/45°F counts as 86/50
+		{"capped day", 95, 45, true, 0, 5, 18},
+		{"frost day", 20, 10, true, 50, 0, 0},
+	}
+	for _, tt := range tests {
+		days := Compute([]weather.DailyForecast{{Date: "2026-02-14", TemperatureMax: tt.max, TemperatureMin: tt.min}}, DefaultBases(tt.imperial))
+		d := days[0]
+		if math.Abs(d.HDD-tt.hdd) > 1e-9 || math.Abs(d.CDD-tt.cdd) > 1e-9 || math.Abs(d.GDD-tt.gdd) > 1e-9 {
+			t.Errorf("%s: got HDD %.1f CDD %.1f GDD %.1f, want %.1f %.1f %.1f",
+				tt.name, d.HDD, d.CDD, d.GDD, tt.hdd, tt.cdd, tt.gdd)
+		}
+	}
+}
+
+func TestComputeCustomBases(t *testing.T) {
+	// UK heating base and a wheat-like GDD base without an effective cap
+	b := Bases{Heating: 15.5, Cooling: 22, Growing: 0, GrowingCap: 100}
+	d := Compute([]weather.DailyForecast{{TemperatureMax: 9, TemperatureMin: 1}}, b)[0]
+	if d.HDD != 10.5 || d.CDD != 0 || d.GDD != 5 {
+		t.Errorf("got %+v, want HDD 10.5, CDD 0, GDD 5", d)
+	}
+}
+
+func TestWeekly(t *testing.T) {
+	var days []Day
+	// Thursday Feb 12 to Tuesday Feb 24, one HDD per day
+	for _, date := range []string{
+		"2026-02-12", "2026-02-13", "2026-02-14", "2026-02-15",
+		"2026-02-16", "2026-02-17", "2026-02-18", "2026-02-19", "2026-02-20", "2026-02-21", "2026-02-22",
+		"2026-02-23", "2026-02-24",
+	} {
+		days = append(days, Day{Date: date, HDD: 1, GDD: 0.5})
+	}
+
+	weeks := Weekly(days)
+	want := []Week{
+		{Start: "2026-02-12", Days: 4, HDD: 4, GDD: 2},
+		{Start: "2026-02-16", Days: 7, HDD: 7, GDD: 3.5},
+		{Start: "2026-02-23", Days: 2, HDD: 2, GDD: 1},
+	}
+	if len(weeks) != len(want) {
+		t.Fatalf("got %d weeks, want %d: %+v", len(weeks), len(want), weeks)
+	}
+	for i := range want {
+		if weeks[i] != want[i] {
+			t.Errorf("week %d = %+v, want %+v", i, weeks[i], want[i])
+		}
+	}
+
+	total := Total(days)
+	if total.Start != "2026-02-12" || total.Days != 13 || total.HDD != 13 || total.GDD != 6.5 {
+		t.Errorf("total = %+v, want 13 days from Feb 12 with 13 HDD and 6.5 GDD", total)
+	}
+}
@@ -0,0 +1,82 @@
+package display
+
+import (
+	"fmt"
+	"goweather/internal/i18n"
+	"goweather/internal/weather"
+	"strings"
+)
+
+// euAQILevel maps a European AQI value to its band:
+// 0 good (<20), 1 fair (<40), 2 moderate (<60), 3 poor (<80), 4 very poor (<=100), 5 extremely poor.
+func euAQILevel(aqi int) int {
+	switch {
+	case aqi < 20:
+		return 0
+	case aqi < 40:
+		return 1
+	case aqi < 60:
+		return 2
+	case aqi < 80:
+		return 3
+	case aqi <= 100:
+		return 4
+	default:
+		return 5
+	}
+}
+
+// usAQILevel maps a US AQI value to the same six-step color scale
+// (good, moderate, unhealthy for sensitive groups, unhealthy, very unhealthy, hazardous).
+func usAQILevel(aqi int) int {
+	switch {
+	case aqi <= 50:
+		return 0
+	case aqi <= 100:
+		return 1
+	case aqi <= 150:
+		return 2
+	case aqi <= 200:
+		return 3
+	case aqi <= 300:
+		return 4
+	default:
+		return 5
+	}
+}
+
+// aqiColor applies the category color for a six-step AQI level.
+func aqiColor(level int, s string) string {
+	switch level {
+	case 0:
+		return Cyan(s)
+	case 1:
+		return Green(s)
+	case 2:
+		return Yellow(s)
+	case 3:
+		return Orange(s)
+	case 4:
+		return Red(s)
+	default:
+		return Magenta(s)
+	}
+}
+
+// writeAirQuality writes the air quality section preceded by a divider.
+func writeAirQuality(b *strings.Builder, aq *weather.AirQuality) {
+	b.WriteString(divider())
+
+	eu := euAQILevel(aq.EuropeanAQI)
+	b.WriteString(padLine(fmt.Sprintf("  %s %s %s  %s %s",
+		i18n.Label("air"),
+		aqiColor(eu, fmt.Sprintf("%d %s", aq.EuropeanAQI, i18n.AQICategory(eu))),
+		Dim("EAQI"),
+		Dim("US AQI"),
+		aqiColor(usAQILevel(aq.USAQI), fmt.Sprintf("%d", aq.USAQI)))))
+	b.WriteString(padLine(fmt.Sprintf("  PM2.5 %s  PM10 %s",
+		Cyan(fmt.Sprintf("%.1f", aq.PM25)), Cyan(fmt.Sprintf("%.1f", aq.PM10)))))
+	b.WriteString(padLine(fmt.Sprintf("  O₃ %s  NO₂ %s  %s",
+		Cyan(fmt.Sprintf("%.0f", aq.Ozone)), Cyan(fmt.Sprintf("%.0f", aq.NitrogenDioxide)),
+		Dim("µg/m³"))))
+}
@@ -12,9 +12,11 @@ const (
 	green   = "\033[32m"
 	yellow  = "\033[33m"
 	blue    = "\033[34m"
+	magenta = "\033[35m"
 	cyan    = "\033[36m"
 	white   = "\033[37m"
 	dimmed  = "\033[2m"
+	orange  = "\033[38;5;208m"
 )
 
 // Bold wraps text in bold ANSI codes if color is enabled.
@@ -48,5 +50,11 @@ func Red(s string) string { return Colored(s, red) }
 // Green returns green-colored text.
 func Green(s string) string { return Colored(s, green) }
 
+// Magenta returns magenta-colored text.
+func Magenta(s string) string { return Colored(s, magenta) }
+
+// Orange returns orange-colored text (256-color palette).
+func Orange(s string) string { return Colored(s, orange) }
+
 // Dim returns dimmed text.
 func Dim(s string) string { return Colored(s, dimmed) }
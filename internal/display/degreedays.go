@@ -0,0 +1,76 @@
+package display
+
+import (
+	"fmt"
+	"goweather/internal/degreedays"
+	"goweather/internal/i18n"
+	"goweather/internal/units"
+	"strings"
+)
+
+// RenderDegreeDaysCard produces the terminal output for the degree-day view:
+// heating, cooling and growing degree days per day with weekly subtotals and
+// a grand total. Days may be forecast or observed.
+func RenderDegreeDaysCard(loc string, days []degreedays.Day, bases degreedays.Bases, imperial bool) string {
+	var b strings.Builder
+
+	b.WriteString(topBorder())
+	b.WriteString(padLine(fmt.Sprintf("  %s", Bold(loc))))
+	unit := units.TempUnit(imperial)
+	b.WriteString(padLine("  " + Dim(fmt.Sprintf("HDD %g%s · CDD %g%s · GDD %g–%g%s",
+		bases.Heating, unit, bases.Cooling, unit, bases.Growing, bases.GrowingCap, unit))))
+	b.WriteString(divider())
+
+	// Columns: Day(10) HDD(8) CDD(8) GDD(8)
+	b.WriteString(padLine(degreeDayRow(Dim(i18n.Label("day")), Dim("HDD"), Dim("CDD"), Dim("GDD"))))
+
+	// Weekly subtotals are only worth showing for more than one week
+	weeks := degreedays.Weekly(days)
+	i := 0
+	for _, w := range weeks {
+		for _, d := range days[i : i+w.Days] {
+			b.WriteString(padLine(degreeDayRow(i18n.FormatDay(d.Date), formatDegreeDays(d.HDD), formatDegreeDays(d.CDD), formatDegreeDays(d.GDD))))
+		}
+		i += w.Days
+		if len(weeks) > 1 {
+			b.WriteString(padLine(degreeDayRow(Cyan("Σ "+i18n.FormatDate(w.Start)),
+				Cyan(formatDegreeDays(w.HDD)), Cyan(formatDegreeDays(w.CDD)), Cyan(formatDegreeDays(w.GDD)))))
+		}
+	}
+
+	total := degreedays.Total(days)
+	b.WriteString(divider())
+	b.WriteString(padLine(degreeDayRow(Bold(i18n.Label("total")),
+		Bold(formatDegreeDays(total.HDD)), Bold(formatDegreeDays(total.CDD)), Bold(formatDegreeDays(total.GDD)))))
+
+	b.WriteString(bottomBorder())
+
+	return b.String()
+}
+
+// formatDegreeDays formats a degree-day value with one decimal.
+func formatDegreeDays(v float64) string {
+	return fmt.Sprintf("%.1f", v)
+}
+
+// degreeDayRow builds a degree-day row with fixed column widths.
+func degreeDayRow(label, hdd, cdd, gdd string) string {
+	var b strings.Builder
+	b.WriteString("  ")
+
+	// Label column: 10 visible columns
+	b.WriteString(label)
+	for pad := 10 - visLen(label); pad > 0; pad-- {
+		b.WriteByte(' ')
+	}
+
+	// Value columns are right-aligned
+	for _, col := range []string{hdd, cdd, gdd} {
+		for pad := 8 - visLen(col); pad > 0; pad-- {
+			b.WriteByte(' ')
+		}
+		b.WriteString(col)
+	}
+
+	return b.String()
+}
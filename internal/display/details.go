@@ -0,0 +1,58 @@
+package display
+
+import (
+	"fmt"
+	"goweather/internal/i18n"
+	"goweather/internal/units"
+	"goweather/internal/weather"
+	"strings"
+)
+
+// ShowDetails controls whether the atmospheric detail section is rendered.
+var ShowDetails = false
+
+// writeDetails writes the atmospheric detail section: pressure with its 3-hour
+// trend, dew point, cloud cover, visibility, wind gusts and, when the model
+// provides it, the freezing level.
+func writeDetails(b *strings.Builder, c weather.CurrentWeather, imperial bool) {
+	b.WriteString(divider())
+
+	pressure := fmt.Sprintf("  %s %s", i18n.Label("pressure"), Cyan(units.FormatPressure(c.Pressure, imperial)))
+	if c.HasPressureTrend {
+		format := "%+.1f"
+		if imperial {
+			format = "%+.2f"
+		}
+		pressure += fmt.Sprintf(" %s %s", pressureArrow(c.PressureTrend),
+			Dim(fmt.Sprintf(format, units.ConvertPressure(c.PressureTrend, imperial))))
+	}
+	b.WriteString(padLine(fmt.Sprintf("%s  %s %s", pressure,
+		i18n.Label("dewpoint"), Cyan(units.FormatTemp(c.DewPoint, imperial)))))
+
+	b.WriteString(padLine(fmt.Sprintf("  %s %s  %s %s  %s %s",
+		i18n.Label("clouds"), Cyan(fmt.Sprintf("%d%%", c.CloudCover)),
+		i18n.Label("visibility"), Cyan(units.FormatDistance(c.Visibility, imperial)),
+		i18n.Label("gusts"), Green(fmt.Sprintf("%.0f %s", c.WindGusts, units.WindUnit(imperial))))))
+
+	if c.HasFreezingLevel {
+		b.WriteString(padLine(fmt.Sprintf("  %s %s",
+			i18n.Label("freezinglevel"), Cyan(units.FormatElevation(c.FreezingLevel, imperial)))))
+	}
+}
+
+// pressureArrow returns an arrow for a 3-hour pressure change in hPa:
+// ↑/↓ for fast changes (3 hPa or more), ↗/↘ for slow ones, → for steady.
+func pressureArrow(delta float64) string {
+	switch {
+	case delta >= 3:
+		return "↑"
+	case delta >= 1:
+		return "↗"
+	case delta <= -3:
+		return "↓"
+	case delta <= -1:
+		return "↘"
+	default:
+		return "→"
+	}
+}
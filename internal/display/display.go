@@ -2,20 +2,143 @@ package display
 
 import (
 	"fmt"
+	"goweather/internal/climate"
 	"goweather/internal/i18n"
 	"goweather/internal/units"
 	"goweather/internal/weather"
 	"strings"
+	"time"
 	"unicode"
 	"unicode/utf8"
 )
 
-const cardWidth = 52
+const cardWidth = 58
 
 // RenderWeatherCard produces the full terminal output for weather data.
 func RenderWeatherCard(loc string, data *weather.WeatherData, imperial bool, days int) string {
 	var b strings.Builder
 
+	writeCurrent(&b, loc, data, imperial)
+	writeNowcastSummary(&b, data.Nowcast, imperial)
+
+	// Daily forecast rows, preceded by any past days
+	daily := data.Daily
+	if data.PastDays+days < len(daily) {
+		daily = daily[:data.PastDays+days]
+	}
+	writeForecastTable(&b, daily, imperial, false, data.PastDays)
+
+	writeExtras(&b, data, imperial)
+
+	// Bottom border
+	b.WriteString(bottomBorder())
+
+	return b.String()
+}
+
+// RenderHistoryCard produces the terminal output for observed past weather,
+// using the same table layout as the forecast.
+func RenderHistoryCard(loc string, daily []weather.DailyForecast, imperial bool) string {
+	var b strings.Builder
+
+	b.WriteString(topBorder())
+	b.WriteString(padLine(fmt.Sprintf("  %s", Bold(loc))))
+	if len(daily) > 0 {
+		span := daily[0].Date
+		if last := daily[len(daily)-1].Date; last != span {
+			span += " – " + last
+		}
+		b.WriteString(padLine(fmt.Sprintf("  %s %s", i18n.Label("observed"), Dim(span))))
+	}
+	b.WriteString(divider())
+
+	writeForecastTable(&b, daily, imperial, true, 0)
+
+	b.WriteString(bottomBorder())
+
+	return b.String()
+}
+
+// writeForecastTable writes the daily table header and one row per day.
+// Observed (historical) days show the precipitation amount without a probability.
+// In a forecast the first pastDays rows are dimmed and the following row (today)
+// is highlighted. Tables longer than a week get a separator before each Monday.
+func writeForecastTable(b *strings.Builder, daily []weather.DailyForecast, imperial, observed bool, pastDays int) {
+	// Days compared against climate normals get a one-column marker after Hi
+	markers := false
+	for _, d := range daily {
+		markers = markers || d.HasNormal
+	}
+	hiHeader := Dim(i18n.Label("hi"))
+	if markers {
+		hiHeader += " "
+	}
+
+	// Forecast table with fixed column positions
+	// Columns: Day(8) Hi(6) Lo(6) Precip(11) Cond(rest)
+	b.WriteString(padLine(forecastRow(Dim(i18n.Label("day")), hiHeader, Dim(i18n.Label("lo")), Dim(i18n.Label("precip")), Dim(i18n.Label("cond")), "")))
+
+	for i, d := range daily {
+		if i > 0 && len(daily) > 7 && isMonday(d.Date) {
+			b.WriteString(weekSeparator(d.Date))
+		}
+
+		fc := GetCondition(d.WeatherCode)
+		past := !observed && i < pastDays
+
+		hi := units.FormatTemp(d.TemperatureMax, imperial)
+		if markers {
+			if past {
+				hi += " "
+			} else {
+				hi += anomalyMarker(d, imperial)
+			}
+		}
+
+		precip := formatDailyPrecip(d, imperial)
+		if observed || past {
+			precip = units.FormatPrecip(d.PrecipitationSum, imperial)
+		}
+
+		day := i18n.FormatDay(d.Date)
+		if !observed && i == pastDays {
+			day = Bold(Yellow(day))
+		}
+
+		row := forecastRow(
+			day,
+			hi,
+			units.FormatTemp(d.TemperatureMin, imperial),
+			precip,
+			fc.Emoji,
+			fc.Description,
+		)
+		if past {
+			row = Dim(row)
+		}
+		b.WriteString(padLine(row))
+	}
+}
+
+// isMonday reports whether a date string (YYYY-MM-DD) falls on a Monday.
+func isMonday(date string) bool {
+	t, err := time.Parse("2006-01-02", date)
+	return err == nil && t.Weekday() == time.Monday
+}
+
+// weekSeparator returns a dotted line labeled with the date starting the week.
+func weekSeparator(date string) string {
+	label := " " + i18n.FormatDate(date) + " "
+	fill := cardWidth - 6 - visLen(label)
+	if fill < 0 {
+		fill = 0
+	}
+	return padLine(Dim("  ┈┈" + label + strings.Repeat("┈", fill)))
+}
+
+// writeCurrent writes the top border, location header and current conditions
+// block followed by a divider.
+func writeCurrent(b *strings.Builder, loc string, data *weather.WeatherData, imperial bool) {
 	cond := GetCondition(data.Current.WeatherCode)
 	art := AsciiArt(cond.Category)
 	artLines := strings.Split(art, "\n")
@@ -23,8 +146,15 @@ func RenderWeatherCard(loc string, data *weather.WeatherData, imperial bool, day
 	// Top border
 	b.WriteString(topBorder())
 
-	// Header: location + emoji
-	header := fmt.Sprintf("  %s  %s", Bold(loc), cond.Emoji)
+	writeStale(b, time.Now())
+	writeWarnings(b, data.Warnings, imperial)
+
+	// Header: location, elevation + emoji
+	name := Bold(loc)
+	if data.HasElevation {
+		name += Dim(" · " + units.FormatElevation(data.Elevation, imperial))
+	}
+	header := fmt.Sprintf("  %s  %s", name, cond.Emoji)
 	b.WriteString(padLine(header))
 
 	b.WriteString(emptyLine())
@@ -32,16 +162,36 @@ func RenderWeatherCard(loc string, data *weather.WeatherData, imperial bool, day
 	// Current conditions alongside ASCII art
 	infoLines := []string{
 		fmt.Sprintf("%s %s", cond.Emoji, Bold(cond.Description)),
-		fmt.Sprintf("%s (%s %s)",
-			Yellow(units.FormatTemp(data.Current.Temperature, imperial)),
-			i18n.Label("feels"),
-			units.FormatTemp(data.Current.ApparentTemperature, imperial)),
+		temperatureLine(data.Current, imperial),
 		fmt.Sprintf("%s %s", i18n.Label("humidity"), Cyan(fmt.Sprintf("%d%%", data.Current.Humidity))),
 		fmt.Sprintf("%s %s %s", i18n.Label("wind"),
 			Green(fmt.Sprintf("%.0f %s", data.Current.WindSpeed, units.WindUnit(imperial))),
 			units.WindCardinal(data.Current.WindDirection)),
 	}
 
+	// UV index now, with today's maximum when available
+	forecast := data.Forecast()
+	uvLine := fmt.Sprintf("%s %s", i18n.Label("uv"), FormatUV(data.Current.UVIndex))
+	if len(forecast) > 0 && forecast[0].UVIndexMax > data.Current.UVIndex {
+		uvLine += Dim(fmt.Sprintf(" (%s %.0f)", i18n.Label("hi"), forecast[0].UVIndexMax))
+	}
+	infoLines = append(infoLines, uvLine)
+
+	// Today compared to the climatological normal
+	if len(forecast) > 0 && forecast[0].HasNormal {
+		infoLines = append(infoLines, formatAnomaly(climate.Anomaly(forecast[0])))
+	}
+
+	// Today's sunrise/sunset. Open-Meteo reports these in the location's
+	// timezone (data.Timezone) because the request uses timezone=auto.
+	if len(forecast) > 0 && forecast[0].Sunrise != "" {
+		today := forecast[0]
+		infoLines = append(infoLines, fmt.Sprintf("%s ↑%s ↓%s %s", i18n.Label("sun"),
+			Yellow(i18n.FormatTime(today.Sunrise)),
+			Yellow(i18n.FormatTime(today.Sunset)),
+			Dim("("+formatDuration(today.DaylightDuration)+")")))
+	}
+
 	// Merge ASCII art lines and info lines side by side
 	maxLines := len(artLines)
 	if len(infoLines) > maxLines {
@@ -69,46 +219,83 @@ func RenderWeatherCard(loc string, data *weather.WeatherData, imperial bool, day
 
 	// Divider
 	b.WriteString(divider())
+}
 
-	// Forecast table with fixed column positions
-	// Columns: Day(10) Hi(6) Lo(6) Cond(rest)
-	b.WriteString(padLine(forecastRow(Dim(i18n.Label("day")), Dim(i18n.Label("hi")), Dim(i18n.Label("lo")), Dim(i18n.Label("cond")), "")))
+// writeExtras writes the optional sections (details, air quality, pollen) that
+// were requested alongside the forecast.
+func writeExtras(b *strings.Builder, data *weather.WeatherData, imperial bool) {
+	if ShowDetails && data.Current.HasDetails {
+		writeDetails(b, data.Current, imperial)
+	}
+	if data.AirQuality != nil {
+		writeAirQuality(b, data.AirQuality)
+	}
+	if data.Pollen != nil {
+		writePollen(b, data.Pollen)
+	}
+}
 
-	// Daily forecast rows
-	limit := days
-	if limit > len(data.Daily) {
-		limit = len(data.Daily)
+// anomalyThreshold returns the difference from normal (in the active units)
+// at which a forecast day is marked as unusually warm or cold.
+func anomalyThreshold(imperial bool) float64 {
+	if imperial {
+		return 5
 	}
+	return 3
+}
 
-	for i := 0; i < limit; i++ {
-		d := data.Daily[i]
-		dayName := i18n.FormatDay(d.Date)
-		fc := GetCondition(d.WeatherCode)
+// anomalyMarker returns a colored ▲/▼ for unusually warm/cold days, or a space.
+func anomalyMarker(d weather.DailyForecast, imperial bool) string {
+	if !d.HasNormal {
+		return " "
+	}
+	switch delta := climate.Anomaly(d); {
+	case delta >= anomalyThreshold(imperial):
+		return Red("▲")
+	case delta <= -anomalyThreshold(imperial):
+		return Blue("▼")
+	default:
+		return " "
+	}
+}
 
-		row := forecastRow(
-			dayName,
-			units.FormatTemp(d.TemperatureMax, imperial),
-			units.FormatTemp(d.TemperatureMin, imperial),
-			fc.Emoji,
-			fc.Description,
-		)
-		b.WriteString(padLine(row))
+// formatAnomaly formats a temperature difference from normal, e.g. "+4° above normal".
+func formatAnomaly(delta float64) string {
+	s := i18n.Anomaly(delta, fmt.Sprintf("%+.0f°", delta))
+	switch {
+	case delta >= 1:
+		return Red(s)
+	case delta <= -1:
+		return Blue(s)
+	default:
+		return Dim(s)
 	}
+}
 
-	// Bottom border
-	b.WriteString(bottomBorder())
+// formatDuration formats a duration in seconds as "9h 53m".
+func formatDuration(seconds float64) string {
+	mins := int(seconds / 60)
+	return fmt.Sprintf("%dh %02dm", mins/60, mins%60)
+}
 
-	return b.String()
+// formatDailyPrecip formats a day's precipitation amount and probability,
+// highlighting days where precipitation is likely.
+func formatDailyPrecip(d weather.DailyForecast, imperial bool) string {
+	s := fmt.Sprintf("%s %d%%", units.FormatPrecip(d.PrecipitationSum, imperial), d.PrecipitationProbability)
+	if d.PrecipitationProbability >= 50 {
+		return Blue(s)
+	}
+	return s
 }
 
 // forecastRow builds a forecast row with fixed column widths using visual padding.
-func forecastRow(day, hi, lo, emoji, desc string) string {
+func forecastRow(day, hi, lo, precip, emoji, desc string) string {
 	var b strings.Builder
 	b.WriteString("  ")
 
-	// Day column: 10 visible columns
+	// Day column: 8 visible columns
 	b.WriteString(day)
-	for pad := 10 - visLen(day); pad > 0; pad-- {
+	for pad := 8 - visLen(day); pad > 0; pad-- {
 		b.WriteByte(' ')
 	}
 
@@ -126,6 +313,12 @@ func forecastRow(day, hi, lo, emoji, desc string) string {
 	}
 	b.WriteString(loStr)
 
+	// Precipitation column: 11 visible columns, right-aligned
+	for pad := 11 - visLen(precip); pad > 0; pad-- {
+		b.WriteByte(' ')
+	}
+	b.WriteString(precip)
+
 	// Condition column
 	b.WriteString("  ")
 	b.WriteString(emoji)
@@ -141,6 +334,16 @@ func topBorder() string {
 	return fmt.Sprintf("\u250C%s\u2510\n", strings.Repeat("\u2500", cardWidth))
 }
 
+// temperatureLine returns the current temperature, with the apparent
+// temperature when the provider reports one.
+func temperatureLine(cur weather.CurrentWeather, imperial bool) string {
+	temp := Yellow(units.FormatTemp(cur.Temperature, imperial))
+	if !cur.HasApparentTemperature {
+		return temp
+	}
+	return fmt.Sprintf("%s (%s %s)", temp, i18n.Label("feels"), units.FormatTemp(cur.ApparentTemperature, imperial))
+}
+
 func bottomBorder() string {
 	return fmt.Sprintf("\u2514%s\u2518\n", strings.Repeat("\u2500", cardWidth))
 }
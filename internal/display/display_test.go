@@ -1,10 +1,16 @@
 package display
 
 import (
+	"fmt"
+	"goweather/internal/degreedays"
+	"goweather/internal/flood"
+	"goweather/internal/garden"
 	"goweather/internal/i18n"
+	"goweather/internal/solar"
 	"goweather/internal/weather"
 	"strings"
 	"testing"
+	"time"
 )
 
 func init() {
@@ -14,13 +20,14 @@ func init() {
 func TestRenderWeatherCard(t *testing.T) {
 	data := &weather.WeatherData{
 		Current: weather.CurrentWeather{
-			Temperature:         18.5,
-			ApparentTemperature: 16.2,
-			Humidity:            55,
-			WindSpeed:           12.0,
-			WindDirection:       240,
-			WeatherCode:        0,
-			Time:               "2026-02-14T12:00",
+			Temperature:            18.5,
+			ApparentTemperature:    16.2,
+			HasApparentTemperature: true,
+			Humidity:               55,
+			WindSpeed:              12.0,
+			WindDirection:          240,
+			WeatherCode:            0,
+			Time:                   "2026-02-14T12:00",
 		},
 		Daily: []weather.DailyForecast{
 			{Date: "2026-02-14", TemperatureMax: 20, TemperatureMin: 12, WeatherCode: 0},
@@ -54,17 +61,312 @@ func TestRenderWeatherCard(t *testing.T) {
 	if !strings.Contains(output, "\u2600") { // sun
 		t.Error("output missing sun emoji for clear sky")
 	}
+
+	if !strings.Contains(output, "feels 16°C") {
+		t.Error("output missing apparent temperature")
+	}
+	data.Current.HasApparentTemperature = false
+	if output := RenderWeatherCard("Berlin, Germany", data, false, 3); strings.Contains(output, "feels") {
+		t.Error("output should leave out an apparent temperature that is not set")
+	}
+}
+
+func TestRenderWeatherCardPrecipitation(t *testing.T) {
+	data := &weather.WeatherData{
+		Current: weather.CurrentWeather{WeatherCode: 61},
+		Daily: []weather.DailyForecast{
+			{Date: "2026-02-14", TemperatureMax: 8, TemperatureMin: 3, WeatherCode: 61, PrecipitationSum: 4.6, PrecipitationProbability: 90},
+			{Date: "2026-02-15", TemperatureMax: 7, TemperatureMin: 2, WeatherCode: 61, PrecipitationSum: 0.2, PrecipitationProbability: 10},
+		},
+	}
+
+	ColorEnabled = false
+	defer func() { ColorEnabled = true }()
+
+	output := RenderWeatherCard("Berlin", data, false, 2)
+	if !strings.Contains(output, "Precip.") {
+		t.Error("output missing precipitation column header")
+	}
+	if !strings.Contains(output, "4.6mm 90%") {
+		t.Error("output missing metric precipitation for day 1")
+	}
+	if !strings.Contains(output, "0.2mm 10%") {
+		t.Error("output missing metric precipitation for day 2")
+	}
+
+	output = RenderWeatherCard("Berlin", data, true, 1)
+	if !strings.Contains(output, "4.60in 90%") {
+		t.Error("output missing imperial precipitation")
+	}
+}
+
+func TestRenderWeatherCardSunriseSunset(t *testing.T) {
+	data := &weather.WeatherData{
+		Current: weather.CurrentWeather{WeatherCode: 0},
+		Daily: []weather.DailyForecast{
+			{Date: "2026-02-14", Sunrise: "2026-02-14T07:28", Sunset: "2026-02-14T17:21", DaylightDuration: 35580},
+		},
+		Timezone: "Europe/Berlin",
+	}
+
+	ColorEnabled = false
+	defer func() { ColorEnabled = true }()
+
+	output := RenderWeatherCard("Berlin", data, false, 1)
+	if !strings.Contains(output, "↑7:28 AM ↓5:21 PM (9h 53m)") {
+		t.Errorf("output missing 12h sunrise/sunset line:\n%s", output)
+	}
+
+	i18n.Init("de")
+	defer i18n.Init("en")
+	output = RenderWeatherCard("Berlin", data, false, 1)
+	if !strings.Contains(output, "↑07:28 ↓17:21") {
+		t.Errorf("output missing 24h sunrise/sunset line:\n%s", output)
+	}
+}
+
+func TestUVLevel(t *testing.T) {
+	tests := []struct {
+		uv   float64
+		want int
+	}{
+		{0, 0},
+		{2.4, 0},
+		{2.5, 1},
+		{5, 1},
+		{6, 2},
+		{7.4, 2},
+		{8, 3},
+		{10, 3},
+		{11, 4},
+		{14.2, 4},
+	}
+	for _, tt := range tests {
+		if got := uvLevel(tt.uv); got != tt.want {
+			t.Errorf("uvLevel(%.1f) = %d, want %d", tt.uv, got, tt.want)
+		}
+	}
+}
+
+func TestRenderWeatherCardUV(t *testing.T) {
+	data := &weather.WeatherData{
+		Current: weather.CurrentWeather{WeatherCode: 0, UVIndex: 6.2},
+		Daily: []weather.DailyForecast{
+			{Date: "2026-07-14", UVIndexMax: 8.1},
+		},
+	}
+
+	ColorEnabled = false
+	defer func() { ColorEnabled = true }()
+
+	output := RenderWeatherCard("Berlin", data, false, 1)
+	if !strings.Contains(output, "UV: 6 High (Hi 8)") {
+		t.Errorf("output missing UV line:\n%s", output)
+	}
+}
+
+func TestRenderWeatherCardAirQuality(t *testing.T) {
+	data := &weather.WeatherData{
+		Current: weather.CurrentWeather{WeatherCode: 0},
+		Daily: []weather.DailyForecast{
+			{Date: "2026-02-14"},
+		},
+		AirQuality: &weather.AirQuality{PM25: 11.4, PM10: 16.9, Ozone: 48, NitrogenDioxide: 21.3, EuropeanAQI: 34, USAQI: 47},
+	}
+
+	ColorEnabled = false
+	defer func() { ColorEnabled = true }()
+
+	output := RenderWeatherCard("Berlin", data, false, 1)
+	for _, want := range []string{"Air quality: 34 Fair EAQI", "US AQI 47", "PM2.5 11.4", "NO₂ 21"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("output missing %q:\n%s", want, output)
+		}
+	}
+}
+
+func TestRenderWeatherCardPollen(t *testing.T) {
+	data := &weather.WeatherData{
+		Current: weather.CurrentWeather{WeatherCode: 0},
+		Daily: []weather.DailyForecast{
+			{Date: "2026-04-14"},
+		},
+		Pollen: &weather.PollenForecast{
+			Available: true,
+			Daily: []weather.DailyPollen{
+				{Date: "2026-04-14", Levels: [6]float64{4.3, 40, 0, 0, 0, 0}},
+				{Date: "2026-04-15", Levels: [6]float64{5.1, 120, 0, 0, 0, 0}},
+			},
+		},
+	}
+
+	ColorEnabled = false
+	defer func() { ColorEnabled = true }()
+
+	output := RenderWeatherCard("Berlin", data, false, 1)
+	for _, want := range []string{"Tue 14", "Wed 15", "Birch", "Ragweed"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("output missing %q:\n%s", want, output)
+		}
+	}
+	if !strings.Contains(output, "40    120") {
+		t.Errorf("output missing birch levels:\n%s", output)
+	}
+
+	data.Pollen = &weather.PollenForecast{Available: false}
+	output = RenderWeatherCard("New York", data, false, 1)
+	if !strings.Contains(output, "No pollen data for this region") {
+		t.Errorf("output missing pollen unavailable message:\n%s", output)
+	}
+	if strings.Contains(output, "Birch") {
+		t.Error("output should not render a pollen table when data is unavailable")
+	}
+}
+
+func TestRenderMarineCard(t *testing.T) {
+	data := &weather.WeatherData{
+		Current: weather.CurrentWeather{WeatherCode: 0},
+		Marine: &weather.MarineData{
+			Current: weather.MarineConditions{
+				WaveHeight: 0.84, WaveDirection: 248, WavePeriod: 3.9,
+				SwellWaveHeight: 0.22, SwellWaveDirection: 275, SwellWavePeriod: 6.45,
+				SeaSurfaceTemperature: 18.3,
+			},
+			Daily: []weather.DailyMarine{
+				{Date: "2026-07-11", WaveHeightMax: 1.02, WaveDirectionDominant: 250, WavePeriodMax: 4.25, SwellWaveHeightMax: 0.3},
+				{Date: "2026-07-12", WaveHeightMax: 0.64, WaveDirectionDominant: 231, WavePeriodMax: 3.8, SwellWaveHeightMax: 0.18},
+			},
+		},
+	}
+
+	ColorEnabled = false
+	defer func() { ColorEnabled = true }()
+
+	output := RenderMarineCard("Kiel", data, false, 2)
+	for _, want := range []string{"0.8m WSW  4s", "0.2m WNW  6s", "18°C", "Sat 11", "1.0m", "0.3m"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("output missing %q:\n%s", want, output)
+		}
+	}
+
+	output = RenderMarineCard("Kiel", data, true, 1)
+	for _, want := range []string{"2.8ft WSW", "65°F", "3.3ft"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("imperial output missing %q:\n%s", want, output)
+		}
+	}
+	if strings.Contains(output, "Sun 12") {
+		t.Error("output should be limited to the requested number of days")
+	}
+}
+
+func TestRenderHistoryCard(t *testing.T) {
+	daily := []weather.DailyForecast{
+		{Date: "2024-07-13", TemperatureMax: 27.4, TemperatureMin: 15.8, WeatherCode: 3},
+		{Date: "2024-07-14", TemperatureMax: 31.9, TemperatureMin: 18.2, WeatherCode: 95, PrecipitationSum: 12.7},
+	}
+
+	ColorEnabled = false
+	defer func() { ColorEnabled = true }()
+
+	output := RenderHistoryCard("Berlin", daily, false)
+	for _, want := range []string{"Observed 2024-07-13 – 2024-07-14", "Sun 14", "32°C", "12.7mm", "Thunderstorm"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("output missing %q:\n%s", want, output)
+		}
+	}
+	if strings.Contains(output, "12.7mm 0%") {
+		t.Error("observed precipitation should not show a probability")
+	}
+}
+
+func TestRenderWeatherCardAnomaly(t *testing.T) {
+	data := &weather.WeatherData{
+		Current: weather.CurrentWeather{WeatherCode: 0},
+		Daily: []weather.DailyForecast{
+			{Date: "2026-07-14", TemperatureMax: 30, TemperatureMin: 18, NormalMax: 25, NormalMin: 15, HasNormal: true},
+			{Date: "2026-07-15", TemperatureMax: 20, TemperatureMin: 12, NormalMax: 25, NormalMin: 15, HasNormal: true},
+			{Date: "2026-07-16", TemperatureMax: 25, TemperatureMin: 15, NormalMax: 25, NormalMin: 15, HasNormal: true},
+		},
+	}
+
+	ColorEnabled = false
+	defer func() { ColorEnabled = true }()
+
+	output := RenderWeatherCard("Berlin", data, false, 3)
+	if !strings.Contains(output, "+4° above normal") {
+		t.Errorf("output missing anomaly line:\n%s", output)
+	}
+	if !strings.Contains(output, "30°C▲") {
+		t.Errorf("output missing warm marker:\n%s", output)
+	}
+	if !strings.Contains(output, "20°C▼") {
+		t.Errorf("output missing cold marker:\n%s", output)
+	}
+	if strings.Contains(output, "25°C▲") || strings.Contains(output, "25°C▼") {
+		t.Error("near-normal day should not be marked")
+	}
+}
+
+func TestRenderWeatherCardWarnings(t *testing.T) {
+	data := &weather.WeatherData{
+		Current: weather.CurrentWeather{WeatherCode: 95},
+		Daily: []weather.DailyForecast{
+			{Date: "2026-07-14", TemperatureMax: 35, WeatherCode: 95},
+		},
+		Warnings: []weather.Warning{
+			{Kind: "thunderstorm", Date: "2026-07-14"},
+			{Kind: "heat", Date: "2026-07-14", Value: 35},
+		},
+	}
+
+	ColorEnabled = false
+	defer func() { ColorEnabled = true }()
+
+	output := RenderWeatherCard("Berlin", data, false, 1)
+	lines := strings.Split(output, "\n")
+	if !strings.Contains(lines[1], "⚠ Thunderstorm (Tue 14)") {
+		t.Errorf("first card line should be the thunderstorm warning, got %q", lines[1])
+	}
+	if !strings.Contains(lines[2], "⚠ Heat: 35°C (Tue 14)") {
+		t.Errorf("second card line should be the heat warning, got %q", lines[2])
+	}
+
+	ColorEnabled = true
+	output = RenderWeatherCard("Berlin", data, false, 1)
+	if !strings.Contains(output, red) {
+		t.Error("warning banner should be red")
+	}
+}
+
+func TestAQILevels(t *testing.T) {
+	if got := euAQILevel(19); got != 0 {
+		t.Errorf("euAQILevel(19) = %d, want 0", got)
+	}
+	if got := euAQILevel(65); got != 3 {
+		t.Errorf("euAQILevel(65) = %d, want 3", got)
+	}
+	if got := euAQILevel(120); got != 5 {
+		t.Errorf("euAQILevel(120) = %d, want 5", got)
+	}
+	if got := usAQILevel(50); got != 0 {
+		t.Errorf("usAQILevel(50) = %d, want 0", got)
+	}
+	if got := usAQILevel(151); got != 3 {
+		t.Errorf("usAQILevel(151) = %d, want 3", got)
+	}
 }
 
 func TestRenderNoColor(t *testing.T) {
 	data := &weather.WeatherData{
 		Current: weather.CurrentWeather{
-			Temperature:         18.5,
-			ApparentTemperature: 16.2,
-			Humidity:            55,
-			WindSpeed:           12.0,
-			WindDirection:       240,
-			WeatherCode:        0,
+			Temperature:            18.5,
+			ApparentTemperature:    16.2,
+			HasApparentTemperature: true,
+			Humidity:               55,
+			WindSpeed:              12.0,
+			WindDirection:          240,
+			WeatherCode:            0,
 		},
 		Daily: []weather.DailyForecast{
 			{Date: "2026-02-14", TemperatureMax: 20, TemperatureMin: 12, WeatherCode: 0},
@@ -116,3 +418,578 @@ func TestVisLen(t *testing.T) {
 		}
 	}
 }
+
+func TestRenderHourlyCard(t *testing.T) {
+	data := &weather.WeatherData{
+		Current: weather.CurrentWeather{
+			Temperature: 5.2,
+			WeatherCode: 3,
+		},
+		Hourly: []weather.HourlyForecast{
+			{Time: "2026-02-14T16:00", Temperature: 5.0, PrecipitationProbability: 20, WeatherCode: 3, WindSpeed: 12, WindDirection: 240},
+			{Time: "2026-02-14T17:00", Temperature: 4.1, PrecipitationProbability: 80, WeatherCode: 61, WindSpeed: 15, WindDirection: 250},
+			{Time: "2026-02-14T18:00", Temperature: 3.2, PrecipitationProbability: 65, WeatherCode: 61, WindSpeed: 14, WindDirection: 250},
+		},
+	}
+
+	ColorEnabled = false
+	defer func() { ColorEnabled = true }()
+	output := RenderHourlyCard("Berlin", data, false, 2)
+
+	if !strings.Contains(output, "5:00 PM") {
+		t.Error("output missing 5:00 PM row")
+	}
+	if !strings.Contains(output, "80%") {
+		t.Error("output missing precipitation probability")
+	}
+	if strings.Contains(output, "6:00 PM") {
+		t.Error("output should be limited to the requested number of hours")
+	}
+	if !strings.Contains(output, "█") || !strings.Contains(output, "▁") {
+		t.Error("output missing temperature sparkline")
+	}
+	for _, line := range strings.Split(strings.TrimRight(output, "\n"), "\n") {
+		if got := visLen(line); got != cardWidth+2 {
+			t.Errorf("line %q has width %d, want %d", line, got, cardWidth+2)
+		}
+	}
+}
+
+func TestSparkline(t *testing.T) {
+	if got := sparkline([]float64{1, 2, 3, 4, 5, 6, 7, 8}); got != "▁▂▃▄▅▆▇█" {
+		t.Errorf("sparkline(ascending) = %q, want %q", got, "▁▂▃▄▅▆▇█")
+	}
+	if got := sparkline([]float64{3, 3, 3}); got != "▁▁▁" {
+		t.Errorf("sparkline(flat) = %q, want %q", got, "▁▁▁")
+	}
+	if got := sparkline(nil); got != "" {
+		t.Errorf("sparkline(nil) = %q, want empty string", got)
+	}
+}
+
+func TestRenderModelsCard(t *testing.T) {
+	data := &weather.WeatherData{
+		Current: weather.CurrentWeather{WeatherCode: 3},
+		Daily: []weather.DailyForecast{
+			{Date: "2026-02-14"},
+			{Date: "2026-02-15"},
+		},
+	}
+	models := []weather.ModelForecast{
+		{Model: "icon_seamless", Daily: []weather.DailyForecast{
+			{Date: "2026-02-14", TemperatureMax: 6, TemperatureMin: 1, WeatherCode: 3},
+			{Date: "2026-02-15", TemperatureMax: 4, TemperatureMin: 0, WeatherCode: 61, PrecipitationSum: 4.2},
+		}},
+		{Model: "gfs_seamless", Daily: []weather.DailyForecast{
+			{Date: "2026-02-14", TemperatureMax: 8, TemperatureMin: 2, WeatherCode: 2},
+			{Date: "2026-02-15", TemperatureMax: 11, TemperatureMin: 1, WeatherCode: 63},
+		}},
+		{Model: "ecmwf_ifs025", Daily: []weather.DailyForecast{
+			{Date: "2026-02-14", TemperatureMax: 7, TemperatureMin: 1, WeatherCode: 3},
+		}},
+	}
+
+	ColorEnabled = false
+	defer func() { ColorEnabled = true }()
+
+	output := RenderModelsCard("Berlin", data, models, false, 2)
+
+	for _, want := range []string{"Model", "Sat 14  Spread 2°", "Sun 15  Spread 7°", "ICON", "GFS", "ECMWF IFS", "4.2mm", "Slight rain"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("output should contain %q", want)
+		}
+	}
+	// ECMWF has no forecast for the second day
+	if !strings.Contains(output, "ECMWF IFS        –     –") {
+		t.Errorf("missing model days should show dashes, got:\n%s", output)
+	}
+	for i, line := range strings.Split(strings.TrimRight(output, "\n"), "\n") {
+		if visLen(line) != cardWidth+2 {
+			t.Errorf("line %d has width %d, want %d: %q", i, visLen(line), cardWidth+2, line)
+		}
+	}
+}
+
+func TestRenderEnsembleCard(t *testing.T) {
+	data := &weather.WeatherData{Current: weather.CurrentWeather{WeatherCode: 3}}
+	ensemble := []weather.DailyEnsemble{
+		{
+			Date:           "2026-02-14",
+			TemperatureMax: weather.Percentiles{P10: 4.4, P50: 6, P90: 8.6},
+			TemperatureMin: weather.Percentiles{P10: 0.2, P50: 1, P90: 1.8},
+			Precipitation:  weather.Percentiles{P10: 0, P50: 0.2, P90: 1.16},
+		},
+		{
+			Date:           "2026-02-15",
+			TemperatureMax: weather.Percentiles{P10: 2.3, P50: 3.5, P90: 5.4},
+			TemperatureMin: weather.Percentiles{P10: -1.7, P50: -0.5, P90: 0.7},
+			Precipitation:  weather.Percentiles{P10: 0.3, P50: 2.25, P90: 6.79},
+		},
+	}
+
+	ColorEnabled = false
+	defer func() { ColorEnabled = true }()
+
+	output := RenderEnsembleCard("Berlin", data, ensemble, false, 1)
+	if !strings.Contains(output, "4–9°C") || !strings.Contains(output, "0–2°C") {
+		t.Errorf("output should show p10–p90 temperature ranges, got:\n%s", output)
+	}
+	if !strings.Contains(output, "0.0–1.2mm") {
+		t.Errorf("output should show the precipitation range, got:\n%s", output)
+	}
+	if strings.Contains(output, "Sun 15") {
+		t.Error("output should be limited to the requested number of days")
+	}
+	if !strings.Contains(output, "█") || !strings.Contains(output, "░") {
+		t.Error("output should contain the shaded bar")
+	}
+}
+
+func TestEnsembleBar(t *testing.T) {
+	d := weather.DailyEnsemble{
+		TemperatureMax: weather.Percentiles{P10: 8, P50: 10, P90: 12},
+		TemperatureMin: weather.Percentiles{P10: 0, P50: 2, P90: 4},
+	}
+
+	ColorEnabled = false
+	defer func() { ColorEnabled = true }()
+
+	// Scale 0..14: one column per degree
+	got := ensembleBar(d, 0, 14)
+	want := "░░████████░░  "
+	if got != want {
+		t.Errorf("ensembleBar = %q, want %q", got, want)
+	}
+}
+
+func TestRenderWeatherCardPastDaysAndWeeks(t *testing.T) {
+	data := &weather.WeatherData{PastDays: 2}
+	// 2026-02-12 (Thu) .. 2026-02-24 (Tue)
+	for day := 12; day <= 24; day++ {
+		data.Daily = append(data.Daily, weather.DailyForecast{
+			Date:                     fmt.Sprintf("2026-02-%02d", day),
+			TemperatureMax:           float64(day),
+			PrecipitationSum:         1.2,
+			PrecipitationProbability: 60,
+		})
+	}
+
+	ColorEnabled = false
+	defer func() { ColorEnabled = true }()
+
+	output := RenderWeatherCard("Berlin", data, false, 10)
+	if strings.Contains(output, "Tue 24") {
+		t.Error("output should show 2 past days plus 10 forecast days")
+	}
+	if strings.Count(output, "┈┈ Feb 16") != 1 || strings.Count(output, "┈┈ Feb 23") != 1 {
+		t.Errorf("output should have a separator before each Monday, got:\n%s", output)
+	}
+	// Past days show the amount only, forecast days the probability too
+	if !strings.Contains(output, "Thu 12    12°C   0°C      1.2mm") {
+		t.Errorf("past day row should show the precipitation amount only, got:\n%s", output)
+	}
+
+	ColorEnabled = true
+	output = RenderWeatherCard("Berlin", data, false, 10)
+	for _, line := range strings.Split(output, "\n") {
+		switch {
+		case strings.Contains(line, "Fri 13"):
+			if !strings.Contains(line, dimmed) {
+				t.Errorf("past day should be dimmed: %q", line)
+			}
+		case strings.Contains(line, "Sat 14"):
+			if !strings.Contains(line, bold) {
+				t.Errorf("today should be highlighted: %q", line)
+			}
+		case strings.Contains(line, "Sun 15"):
+			if strings.Contains(line, bold) || strings.HasPrefix(strings.TrimPrefix(line, "│  "), dimmed) {
+				t.Errorf("future day should be plain: %q", line)
+			}
+		}
+	}
+}
+
+func TestRenderWeatherCardDetails(t *testing.T) {
+	data := &weather.WeatherData{
+		Current: weather.CurrentWeather{
+			WeatherCode:      3,
+			Pressure:         1012.6,
+			PressureTrend:    -2.2,
+			HasPressureTrend: true,
+			DewPoint:         0.7,
+			CloudCover:       92,
+			Visibility:       24140,
+			WindGusts:        31.7,
+			HasDetails:       true,
+		},
+	}
+
+	ColorEnabled = false
+	defer func() { ColorEnabled = true }()
+
+	if output := RenderWeatherCard("Berlin", data, false, 1); strings.Contains(output, "Pressure") {
+		t.Error("details should only be shown when requested")
+	}
+
+	ShowDetails = true
+	defer func() { ShowDetails = false }()
+
+	output := RenderWeatherCard("Berlin", data, false, 1)
+	for _, want := range []string{"Pressure 1013 hPa ↘ -2.2", "Dew point 1°C", "Clouds 92%", "Visibility 24 km", "Gusts 32 km/h"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("output should contain %q, got:\n%s", want, output)
+		}
+	}
+	if strings.Contains(output, "Freezing level") {
+		t.Error("the freezing level should only be shown when available")
+	}
+
+	data.Current.FreezingLevel, data.Current.HasFreezingLevel = 870, true
+	output = RenderWeatherCard("Berlin", data, true, 1)
+	for _, want := range []string{"29.90 inHg ↘ -0.06", "Visibility 15 mi", "Freezing level 2854 ft"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("imperial output should contain %q, got:\n%s", want, output)
+		}
+	}
+}
+
+func TestPressureArrow(t *testing.T) {
+	tests := []struct {
+		delta float64
+		want  string
+	}{
+		{4, "↑"},
+		{1.2, "↗"},
+		{0.4, "→"},
+		{-0.9, "→"},
+		{-1.5, "↘"},
+		{-3, "↓"},
+	}
+	for _, tt := range tests {
+		if got := pressureArrow(tt.delta); got != tt.want {
+			t.Errorf("pressureArrow(%v) = %q, want %q", tt.delta, got, tt.want)
+		}
+	}
+}
+
+// testNowcast returns a nowcast at 14:00 with the given 15-minute amounts
+// starting at 14:15.
+func testNowcast(amounts ...float64) *weather.Nowcast {
+	n := &weather.Nowcast{Time: "2026-02-14T14:00"}
+	for i, p := range amounts {
+		minutes := (i + 1) * 15
+		n.Steps = append(n.Steps, weather.NowcastStep{
+			Time:          fmt.Sprintf("2026-02-14T%02d:%02d", 14+minutes/60, minutes%60),
+			Precipitation: p,
+		})
+	}
+	return n
+}
+
+func TestNowcastOutlook(t *testing.T) {
+	tests := []struct {
+		name     string
+		amounts  []float64
+		startsIn int
+		stopsAt  string
+	}{
+		{"starts and stops", []float64{0, 0.2, 0.5, 0.3, 0, 0, 0, 0}, 15, "2026-02-14T15:00"},
+		{"raining now", []float64{0.4, 0.6, 0.5, 0.5, 0.4, 0.3, 0.2, 0.2}, 0, ""},
+		{"drizzle below threshold", []float64{0.05, 0, 0, 0, 0, 0, 0, 0}, -1, ""},
+		{"dry", []float64{0, 0, 0, 0, 0, 0, 0, 0}, -1, ""},
+	}
+	for _, tt := range tests {
+		startsIn, stopsAt := nowcastOutlook(testNowcast(tt.amounts...), false)
+		if startsIn != tt.startsIn || stopsAt != tt.stopsAt {
+			t.Errorf("%s: got (%d, %q), want (%d, %q)", tt.name, startsIn, stopsAt, tt.startsIn, tt.stopsAt)
+		}
+	}
+	if startsIn, stopsAt := nowcastOutlook(nil, false); startsIn != -1 || stopsAt != "" {
+		t.Errorf("nil nowcast: got (%d, %q), want (-1, \"\")", startsIn, stopsAt)
+	}
+}
+
+func TestRenderNowcastCard(t *testing.T) {
+	data := &weather.WeatherData{
+		Current: weather.CurrentWeather{WeatherCode: 61},
+		Nowcast: testNowcast(0, 0.2, 0.5, 1.3, 0, 0, 0, 0),
+	}
+
+	ColorEnabled = false
+	defer func() { ColorEnabled = true }()
+
+	output := RenderNowcastCard("Berlin", data, false)
+	for _, want := range []string{
+		"Rain starting in ~15 min, stopping around 3:00 PM",
+		"2:15 PM                                 0.0mm",
+		"3:00 PM " + strings.Repeat("█", nowcastBarWidth) + "  1.3mm",
+		"4:00 PM",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("output should contain %q, got:\n%s", want, output)
+		}
+	}
+	if strings.Contains(output, "2:00 PM") {
+		t.Error("the current step should not be charted")
+	}
+}
+
+func TestRenderWeatherCardNowcastSummary(t *testing.T) {
+	data := &weather.WeatherData{
+		Current: weather.CurrentWeather{WeatherCode: 3},
+		Nowcast: testNowcast(0, 0, 0, 0, 0, 0, 0, 0),
+	}
+
+	ColorEnabled = false
+	defer func() { ColorEnabled = true }()
+
+	if output := RenderWeatherCard("Berlin", data, false, 1); strings.Contains(output, "☔") {
+		t.Errorf("a dry nowcast should not add a summary, got:\n%s", output)
+	}
+
+	data.Nowcast = testNowcast(0.3, 0.4, 0.1, 0, 0, 0, 0, 0)
+	output := RenderWeatherCard("Berlin", data, false, 1)
+	if !strings.Contains(output, "☔ Rain stopping around 2:45 PM") {
+		t.Errorf("output should contain the nowcast summary, got:\n%s", output)
+	}
+}
+
+func TestRenderWeatherCardElevation(t *testing.T) {
+	data := &weather.WeatherData{
+		Current: weather.CurrentWeather{WeatherCode: 0},
+	}
+
+	ColorEnabled = false
+	defer func() { ColorEnabled = true }()
+
+	if output := RenderWeatherCard("Zermatt", data, false, 1); strings.Contains(output, "Zermatt ·") {
+		t.Errorf("header should not show an unknown elevation, got:\n%s", output)
+	}
+
+	data.Elevation, data.HasElevation = 1608, true
+	if output := RenderWeatherCard("Zermatt", data, false, 1); !strings.Contains(output, "Zermatt · 1608 m") {
+		t.Errorf("header should show the elevation in meters, got:\n%s", output)
+	}
+	if output := RenderWeatherCard("Zermatt", data, true, 1); !strings.Contains(output, "Zermatt · 5276 ft") {
+		t.Errorf("header should show the elevation in feet, got:\n%s", output)
+	}
+}
+
+func TestRenderSnowCard(t *testing.T) {
+	data := &weather.WeatherData{
+		Current: weather.CurrentWeather{WeatherCode: 73},
+		Snow: &weather.SnowData{
+			Depth:            85,
+			HasDepth:         true,
+			FreezingLevel:    1570,
+			HasFreezingLevel: true,
+			Daily: []weather.DailySnow{
+				{Date: "2026-02-14", Snowfall: 0, FreezingLevelMin: 1200, FreezingLevelMax: 1600, HasFreezingLevel: true},
+				{Date: "2026-02-15", Snowfall: 18.2, FreezingLevelMin: 900, FreezingLevelMax: 1300, HasFreezingLevel: true},
+				{Date: "2026-02-16", Snowfall: 3.5},
+			},
+		},
+	}
+
+	ColorEnabled = false
+	defer func() { ColorEnabled = true }()
+
+	output := RenderSnowCard("Zermatt", data, false, 3)
+	for _, want := range []string{
+		"Snow depth 85 cm  Freezing level 1570 m",
+		"Sun 15         18 cm    900–1300 m",
+		"Mon 16        3.5 cm    –",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("output should contain %q, got:\n%s", want, output)
+		}
+	}
+
+	output = RenderSnowCard("Zermatt", data, true, 2)
+	for _, want := range []string{"Snow depth 33 in", "Sun 15        7.2 in    2953–4265 ft"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("imperial output should contain %q, got:\n%s", want, output)
+		}
+	}
+	if strings.Contains(output, "Mon 16") {
+		t.Error("output should be limited to the requested days")
+	}
+}
+
+func TestRenderSolarCard(t *testing.T) {
+	panel := solar.Panel{KWp: 6.5, Tilt: 35, Azimuth: 180, Losses: 14}
+	hourly := []solar.HourlyYield{
+		{Time: "2026-02-14T07:00", Power: 0.001},
+		{Time: "2026-02-14T08:00", Power: 0.2},
+		{Time: "2026-02-14T12:00", Power: 3.1},
+		{Time: "2026-02-15T12:00", Power: 1},
+	}
+	daily := []solar.DailyYield{
+		{Date: "2026-02-14", Energy: 14.2},
+		{Date: "2026-02-15", Energy: 4.8},
+	}
+
+	ColorEnabled = false
+	defer func() { ColorEnabled = true }()
+
+	output := RenderSolarCard("Berlin", panel, hourly, daily)
+	for _, want := range []string{
+		"6.5 kWp · 35° · S",
+		"Sat 14    " + strings.Repeat("█", solarBarWidth) + "  14.2 kWh",
+		"Sun 15    █████████ ",
+		"12:00 PM  " + strings.Repeat("█", solarBarWidth) + "  3.10",
+		"8:00 AM   ██ ",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("output should contain %q, got:\n%s", want, output)
+		}
+	}
+	// The curve covers today's daylight hours only
+	if strings.Contains(output, "7:00 AM") || strings.Contains(output, "1.00") {
+		t.Errorf("curve should skip dark hours and other days, got:\n%s", output)
+	}
+}
+
+func TestRenderDegreeDaysCard(t *testing.T) {
+	var daily []weather.DailyForecast
+	for i, date := range []string{"2026-02-14", "2026-02-15", "2026-02-16", "2026-02-17"} {
+		daily = append(daily, weather.DailyForecast{Date: date, TemperatureMax: 8 + float64(i), TemperatureMin: 2})
+	}
+	bases := degreedays.DefaultBases(false)
+	days := degreedays.Compute(daily, bases)
+
+	ColorEnabled = false
+	defer func() { ColorEnabled = true }()
+
+	output := RenderDegreeDaysCard("Berlin", days, bases, false)
+	for _, want := range []string{
+		"HDD 18°C · CDD 18°C · GDD 10–30°C",
+		"Sat 14        13.0     0.0     0.0",
+		"Σ Feb 14      25.5     0.0     0.0",
+		"Σ Feb 16      23.5     0.0     0.5",
+		"Total         49.0     0.0     0.5",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("output should contain %q, got:\n%s", want, output)
+		}
+	}
+
+	// A single week needs no subtotal
+	output = RenderDegreeDaysCard("Berlin", days[2:], bases, false)
+	if strings.Contains(output, "Σ") {
+		t.Errorf("a single week should have no weekly subtotal, got:\n%s", output)
+	}
+
+	output = RenderDegreeDaysCard("Berlin", days, degreedays.DefaultBases(true), true)
+	if !strings.Contains(output, "HDD 65°F · CDD 65°F · GDD 50–86°F") {
+		t.Errorf("imperial bases should be shown in °F, got:\n%s", output)
+	}
+}
+
+func TestRenderGardenCard(t *testing.T) {
+	data := &weather.WeatherData{
+		PastDays:     1,
+		Elevation:    38,
+		HasElevation: true,
+		Soil:         []weather.SoilLevel{{Depth: 0, Temperature: 27.4, Moisture: 0.112}, {Depth: 18, Temperature: 19.6, Moisture: 0.214}},
+	}
+	days := []garden.Day{
+		{Date: "2026-07-05", Precipitation: 1.2, ET0: 4.6, Balance: -3.4},
+		{Date: "2026-07-06", Precipitation: 0, ET0: 5.1, Balance: -5.1},
+		{Date: "2026-07-07", Precipitation: 12, ET0: 2.5, Balance: 9.5},
+	}
+
+	ColorEnabled = false
+	defer func() { ColorEnabled = true }()
+
+	output := RenderGardenCard("Berlin", data, days, garden.Advice{Kind: garden.AdviceWater, Amount: 8.5}, false)
+	for _, want := range []string{
+		"Berlin · 38 m",
+		"💧 Water today: about 8.5mm",
+		"0 cm          27°C       11%",
+		"18 cm         20°C       21%",
+		"Sun 05       1.2mm     4.6mm    -3.4mm",
+		"Tue 07      12.0mm     2.5mm    +9.5mm",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("output should contain %q, got:\n%s", want, output)
+		}
+	}
+
+	output = RenderGardenCard("Berlin", data, days, garden.Advice{Kind: garden.AdviceRain}, true)
+	for _, want := range []string{
+		"No need to water: rain is on the way",
+		"7 in          67°F       21%",
+		"0.47in",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("imperial output should contain %q, got:\n%s", want, output)
+		}
+	}
+}
+
+func TestRenderFloodCard(t *testing.T) {
+	data := &weather.WeatherData{Current: weather.CurrentWeather{WeatherCode: 63}}
+	days := []flood.Day{
+		{Date: "2026-02-14", Discharge: 312.4, Median: 290, Max: 1850, Risk: flood.RiskNormal},
+		{Date: "2026-02-15", Discharge: 802.5, Median: 290, Max: 1850, Risk: flood.RiskElevated},
+		{Date: "2026-02-16", Discharge: 1620, Median: 290, Max: 1850, Risk: flood.RiskHigh},
+		{Date: "2026-02-17", Discharge: 4.2, Risk: flood.RiskNormal},
+	}
+
+	ColorEnabled = false
+	defer func() { ColorEnabled = true }()
+
+	output := RenderFloodCard("Dresden", data, days, false)
+	for _, want := range []string{
+		"🌊 Flood risk: High · Mon 16",
+		"Day           m³/s    Median       Max",
+		"Sat 14         312       290      1850",
+		"Sun 15         802       290      1850  Elevated",
+		"Tue 17         4.2         –         –",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("output should contain %q, got:\n%s", want, output)
+		}
+	}
+
+	output = RenderFloodCard("Dresden", data, days[:1], true)
+	for _, want := range []string{
+		"🌊 Flood risk: Normal",
+		"ft³/s",
+		"Sat 14       11032     10241     65332",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("imperial output should contain %q, got:\n%s", want, output)
+		}
+	}
+}
+
+func TestStaleBanner(t *testing.T) {
+	now := time.Now()
+	StaleAsOf = time.Date(now.Year(), now.Month(), now.Day(), 0, 5, 0, 0, now.Location())
+	defer func() { StaleAsOf = time.Time{} }()
+
+	ColorEnabled = false
+	defer func() { ColorEnabled = true }()
+
+	output := RenderWeatherCard("Berlin", &weather.WeatherData{Current: weather.CurrentWeather{WeatherCode: 2}}, false, 5)
+	if !strings.Contains(output, "⚠ Stale, as of 12:05 AM") {
+		t.Errorf("output should contain the stale banner, got:\n%s", output)
+	}
+
+	// Data from another day shows the day
+	asOf := time.Date(2026, 2, 14, 8, 30, 0, 0, time.UTC)
+	if got := formatAsOf(asOf, asOf.AddDate(0, 0, 1)); got != "Sat 14 8:30 AM" {
+		t.Errorf("formatAsOf = %q, want %q", got, "Sat 14 8:30 AM")
+	}
+	if got := formatAsOf(asOf, asOf.Add(5*time.Hour)); got != "8:30 AM" {
+		t.Errorf("formatAsOf = %q, want %q", got, "8:30 AM")
+	}
+
+	StaleAsOf = time.Time{}
+	output = RenderWeatherCard("Berlin", &weather.WeatherData{Current: weather.CurrentWeather{WeatherCode: 2}}, false, 5)
+	if strings.Contains(output, "Stale") {
+		t.Errorf("fresh data should have no stale banner, got:\n%s", output)
+	}
+}
@@ -0,0 +1,136 @@
+package display
+
+import (
+	"fmt"
+	"goweather/internal/i18n"
+	"goweather/internal/units"
+	"goweather/internal/weather"
+	"strings"
+)
+
+// ensembleBarWidth is the width of the shaded temperature bar in columns.
+const ensembleBarWidth = 14
+
+// RenderEnsembleCard produces the terminal output for the ensemble view:
+// current conditions and a table of 10th–90th percentile ranges per day, with a
+// shaded bar placing each day's temperatures on a common scale.
+func RenderEnsembleCard(loc string, data *weather.WeatherData, ensemble []weather.DailyEnsemble, imperial bool, days int) string {
+	var b strings.Builder
+
+	writeCurrent(&b, loc, data, imperial)
+
+	if days < len(ensemble) {
+		ensemble = ensemble[:days]
+	}
+
+	// Common scale for the bars: coldest p10 minimum to warmest p90 maximum
+	var lo, hi float64
+	for i, d := range ensemble {
+		if i == 0 || d.TemperatureMin.P10 < lo {
+			lo = d.TemperatureMin.P10
+		}
+		if i == 0 || d.TemperatureMax.P90 > hi {
+			hi = d.TemperatureMax.P90
+		}
+	}
+
+	// Columns: Day(8) Hi(9) Lo(9) Precip(12) Bar(rest)
+	b.WriteString(padLine(ensembleRow(Dim(i18n.Label("day")), Dim(i18n.Label("hi")), Dim(i18n.Label("lo")), Dim(i18n.Label("precip")), "")))
+
+	for _, d := range ensemble {
+		b.WriteString(padLine(ensembleRow(
+			i18n.FormatDay(d.Date),
+			formatTempRange(d.TemperatureMax, imperial),
+			formatTempRange(d.TemperatureMin, imperial),
+			formatPrecipRange(d.Precipitation, imperial),
+			ensembleBar(d, lo, hi),
+		)))
+	}
+
+	// Legend
+	b.WriteString(emptyLine())
+	b.WriteString(padLine(Dim("  ░ 10–90%  █ 50%")))
+
+	writeExtras(&b, data, imperial)
+
+	b.WriteString(bottomBorder())
+
+	return b.String()
+}
+
+// formatTempRange formats the 10th–90th percentile range of a temperature, e.g. "4–9°C".
+func formatTempRange(p weather.Percentiles, imperial bool) string {
+	return fmt.Sprintf("%.0f–%s", p.P10, units.FormatTemp(p.P90, imperial))
+}
+
+// formatPrecipRange formats the 10th–90th percentile range of a precipitation
+// amount, highlighting days where even the median member expects precipitation.
+func formatPrecipRange(p weather.Percentiles, imperial bool) string {
+	lo := strings.TrimSuffix(units.FormatPrecip(p.P10, imperial), units.PrecipUnit(imperial))
+	s := lo + "–" + units.FormatPrecip(p.P90, imperial)
+	wet := 1.0
+	if imperial {
+		wet = 0.04
+	}
+	if p.P50 >= wet {
+		return Blue(s)
+	}
+	return s
+}
+
+// ensembleBar renders a day on the lo..hi temperature scale: the 10th percentile
+// minimum to the 90th percentile maximum is lightly shaded, the median minimum
+// to the median maximum is solid.
+func ensembleBar(d weather.DailyEnsemble, lo, hi float64) string {
+	var b strings.Builder
+	for i := 0; i < ensembleBarWidth; i++ {
+		// Temperature at the centre of the cell
+		v := lo
+		if hi > lo {
+			v = lo + (float64(i)+0.5)/ensembleBarWidth*(hi-lo)
+		}
+		switch {
+		case v >= d.TemperatureMin.P50 && v <= d.TemperatureMax.P50:
+			b.WriteString(Yellow("█"))
+		case v >= d.TemperatureMin.P10 && v <= d.TemperatureMax.P90:
+			b.WriteString(Yellow("░"))
+		default:
+			b.WriteByte(' ')
+		}
+	}
+	return b.String()
+}
+
+// ensembleRow builds an ensemble table row with fixed column widths.
+func ensembleRow(day, hi, lo, precip, bar string) string {
+	var b strings.Builder
+	b.WriteString("  ")
+
+	// Day column: 8 visible columns
+	b.WriteString(day)
+	for pad := 8 - visLen(day); pad > 0; pad-- {
+		b.WriteByte(' ')
+	}
+
+	// Hi and Lo columns: 9 visible columns each, right-aligned
+	for _, s := range []string{hi, lo} {
+		for pad := 9 - visLen(s); pad > 0; pad-- {
+			b.WriteByte(' ')
+		}
+		b.WriteString(s)
+	}
+
+	// Precipitation column: 12 visible columns, right-aligned
+	for pad := 12 - visLen(precip); pad > 0; pad-- {
+		b.WriteByte(' ')
+	}
+	b.WriteString(precip)
+
+	// Bar column
+	if bar != "" {
+		b.WriteString("  ")
+		b.WriteString(bar)
+	}
+
+	return b.String()
+}
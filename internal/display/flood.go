@@ -0,0 +1,103 @@
+package display
+
+import (
+	"fmt"
+	"goweather/internal/flood"
+	"goweather/internal/i18n"
+	"goweather/internal/units"
+	"goweather/internal/weather"
+	"strings"
+)
+
+// RenderFloodCard produces the terminal output for the flood view: current
+// conditions, the highest flood risk ahead, and the daily river discharge
+// forecast against the median and maximum on record for the time of year.
+func RenderFloodCard(loc string, data *weather.WeatherData, days []flood.Day, imperial bool) string {
+	var b strings.Builder
+
+	writeCurrent(&b, loc, data, imperial)
+
+	if worst, ok := flood.Worst(days); ok {
+		summary := riskColor(worst.Risk, i18n.FloodRisk(worst.Risk))
+		if worst.Risk != flood.RiskNormal {
+			summary += Dim(" · " + i18n.FormatDay(worst.Date))
+		}
+		b.WriteString(padLine(fmt.Sprintf("  🌊 %s: %s", i18n.Label("floodrisk"), summary)))
+		b.WriteString(emptyLine())
+	}
+
+	// Columns: Day(8) Discharge(10) Median(10) Max(10) Risk(rest); the unit
+	// heads the discharge column
+	b.WriteString(padLine(floodRow(Dim(i18n.Label("day")), Dim(units.DischargeUnit(imperial)),
+		Dim(i18n.Label("median")), Dim(i18n.Label("max")), "")))
+	for _, d := range days {
+		median, max := Dim("–"), Dim("–")
+		if d.Max > 0 {
+			median, max = formatDischarge(d.Median, imperial), formatDischarge(d.Max, imperial)
+		}
+		discharge := formatDischarge(d.Discharge, imperial)
+		risk := ""
+		if d.Risk != flood.RiskNormal {
+			discharge = riskColor(d.Risk, discharge)
+			risk = riskColor(d.Risk, i18n.FloodRisk(d.Risk))
+		}
+		b.WriteString(padLine(floodRow(i18n.FormatDay(d.Date), discharge, median, max, risk)))
+	}
+
+	writeExtras(&b, data, imperial)
+
+	b.WriteString(bottomBorder())
+
+	return b.String()
+}
+
+// riskColor colors s by flood risk level.
+func riskColor(level, s string) string {
+	switch level {
+	case flood.RiskSevere:
+		return Bold(Red(s))
+	case flood.RiskHigh:
+		return Orange(s)
+	case flood.RiskElevated:
+		return Yellow(s)
+	default:
+		return Green(s)
+	}
+}
+
+// formatDischarge formats a discharge given in m³/s in the unit system's
+// unit, without the unit, with one decimal for small streams.
+func formatDischarge(m3s float64, imperial bool) string {
+	v := units.ConvertDischarge(m3s, imperial)
+	if v < 10 {
+		return fmt.Sprintf("%.1f", v)
+	}
+	return fmt.Sprintf("%.0f", v)
+}
+
+// floodRow builds a discharge forecast row with fixed column widths.
+func floodRow(day, discharge, median, max, risk string) string {
+	var b strings.Builder
+	b.WriteString("  ")
+
+	// Day column: 8 visible columns
+	b.WriteString(day)
+	for pad := 8 - visLen(day); pad > 0; pad-- {
+		b.WriteByte(' ')
+	}
+
+	// Discharge, median and max: 10 visible columns each, right-aligned
+	for _, v := range []string{discharge, median, max} {
+		for pad := 10 - visLen(v); pad > 0; pad-- {
+			b.WriteByte(' ')
+		}
+		b.WriteString(v)
+	}
+
+	if risk != "" {
+		b.WriteString("  ")
+		b.WriteString(risk)
+	}
+
+	return b.String()
+}
@@ -0,0 +1,108 @@
+package display
+
+import (
+	"fmt"
+	"goweather/internal/garden"
+	"goweather/internal/i18n"
+	"goweather/internal/units"
+	"goweather/internal/weather"
+	"strings"
+)
+
+// RenderGardenCard produces the terminal output for the garden view: the
+// watering recommendation, soil temperature and moisture by depth, and the
+// daily water balance. The data is metric and converted for display; the
+// first data.PastDays balance rows are dimmed and the next one is today.
+func RenderGardenCard(loc string, data *weather.WeatherData, days []garden.Day, advice garden.Advice, imperial bool) string {
+	var b strings.Builder
+
+	b.WriteString(topBorder())
+
+	// Header: location, elevation + recommendation
+	name := Bold(loc)
+	if data.HasElevation {
+		name += Dim(" · " + units.FormatElevation(data.Elevation, imperial))
+	}
+	b.WriteString(padLine(fmt.Sprintf("  %s  🌱", name)))
+	b.WriteString(emptyLine())
+	text := i18n.GardenAdvice(advice.Kind, formatWater(advice.Amount, imperial))
+	if advice.Kind == garden.AdviceWater {
+		text = Bold(Orange(text))
+	} else {
+		text = Green(text)
+	}
+	b.WriteString(padLine("  💧 " + text))
+
+	// Soil conditions from the surface down
+	if len(data.Soil) > 0 {
+		b.WriteString(divider())
+		b.WriteString(padLine(gardenRow(Dim(i18n.Label("soil")), Dim(i18n.Label("temp")), Dim(i18n.Label("moisture")))))
+		for _, s := range data.Soil {
+			depth := fmt.Sprintf("%d cm", s.Depth)
+			temp := s.Temperature
+			if imperial {
+				depth = fmt.Sprintf("%.0f in", units.CentimetersToInches(float64(s.Depth)))
+				temp = units.CelsiusToFahrenheit(temp)
+			}
+			b.WriteString(padLine(gardenRow(depth, units.FormatTemp(temp, imperial), Cyan(fmt.Sprintf("%.0f%%", s.Moisture*100)))))
+		}
+	}
+
+	// Columns: Day(8) Precip(10) ET₀(10) Balance(10)
+	b.WriteString(divider())
+	b.WriteString(padLine(gardenRow(Dim(i18n.Label("day")), Dim(i18n.Label("precip")), Dim("ET₀"), Dim(i18n.Label("balance")))))
+	for i, d := range days {
+		balance := formatWater(d.Balance, imperial)
+		if d.Balance >= 0 {
+			balance = "+" + balance
+		}
+		day := i18n.FormatDay(d.Date)
+		past := i < data.PastDays
+		if !past {
+			if d.Balance < 0 {
+				balance = Orange(balance)
+			} else {
+				balance = Blue(balance)
+			}
+		}
+		if i == data.PastDays {
+			day = Bold(Yellow(day))
+		}
+		row := gardenRow(day, formatWater(d.Precipitation, imperial), formatWater(d.ET0, imperial), balance)
+		if past {
+			row = Dim(row)
+		}
+		b.WriteString(padLine(row))
+	}
+
+	b.WriteString(bottomBorder())
+
+	return b.String()
+}
+
+// formatWater formats an amount of water given in mm, converting to inches
+// for imperial.
+func formatWater(mm float64, imperial bool) string {
+	if imperial {
+		mm = units.MillimetersToInches(mm)
+	}
+	return units.FormatPrecip(mm, imperial)
+}
+
+// gardenRow builds a soil or water balance row: a label column of 8 visible
+// columns followed by right-aligned value columns of 10.
+func gardenRow(label string, values ...string) string {
+	var b strings.Builder
+	b.WriteString("  ")
+	b.WriteString(label)
+	for pad := 8 - visLen(label); pad > 0; pad-- {
+		b.WriteByte(' ')
+	}
+	for _, v := range values {
+		for pad := 10 - visLen(v); pad > 0; pad-- {
+			b.WriteByte(' ')
+		}
+		b.WriteString(v)
+	}
+	return b.String()
+}
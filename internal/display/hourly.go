@@ -0,0 +1,130 @@
+package display
+
+import (
+	"fmt"
+	"goweather/internal/i18n"
+	"goweather/internal/units"
+	"goweather/internal/weather"
+	"strings"
+)
+
+// sparkBlocks are the eight block heights used for sparklines, lowest first.
+var sparkBlocks = []rune("▁▂▃▄▅▆▇█")
+
+// RenderHourlyCard produces the terminal output for the hourly forecast view:
+// current conditions, a temperature sparkline and a table of the next hours.
+func RenderHourlyCard(loc string, data *weather.WeatherData, imperial bool, hours int) string {
+	var b strings.Builder
+
+	writeCurrent(&b, loc, data, imperial)
+
+	limit := hours
+	if limit > len(data.Hourly) {
+		limit = len(data.Hourly)
+	}
+	hourly := data.Hourly[:limit]
+
+	// Temperature sparkline with min/max legend
+	if len(hourly) > 1 {
+		temps := make([]float64, len(hourly))
+		for i, h := range hourly {
+			temps[i] = h.Temperature
+		}
+		lo, hi := minMax(temps)
+		b.WriteString(padLine("  " + Yellow(sparkline(temps))))
+		b.WriteString(padLine(Dim(fmt.Sprintf("  %s %s – %s", i18n.Label("temp"),
+			units.FormatTemp(lo, imperial), units.FormatTemp(hi, imperial)))))
+		b.WriteString(emptyLine())
+	}
+
+	// Columns: Time(8) Temp(6) Rain(8) Cond+Wind(rest)
+	b.WriteString(padLine(hourlyRow(Dim(i18n.Label("time")), Dim(i18n.Label("temp")), Dim(i18n.Label("rain")), Dim(i18n.Label("cond")), "")))
+
+	for _, h := range hourly {
+		fc := GetCondition(h.WeatherCode)
+		wind := fmt.Sprintf("%.0f %s %s", h.WindSpeed, units.WindUnit(imperial), units.WindCardinal(h.WindDirection))
+		pop := fmt.Sprintf("%d%%", h.PrecipitationProbability)
+		if h.PrecipitationProbability >= 50 {
+			pop = Blue(pop)
+		}
+		b.WriteString(padLine(hourlyRow(
+			i18n.FormatTime(h.Time),
+			units.FormatTemp(h.Temperature, imperial),
+			pop,
+			fc.Emoji,
+			wind,
+		)))
+	}
+
+	writeExtras(&b, data, imperial)
+
+	b.WriteString(bottomBorder())
+
+	return b.String()
+}
+
+// hourlyRow builds an hourly table row with fixed column widths.
+func hourlyRow(hour, temp, pop, emoji, wind string) string {
+	var b strings.Builder
+	b.WriteString("  ")
+
+	// Time column: 8 visible columns (fits "12:00 PM")
+	b.WriteString(hour)
+	for pad := 8 - visLen(hour); pad > 0; pad-- {
+		b.WriteByte(' ')
+	}
+
+	// Temp column: 6 visible columns, right-aligned
+	for pad := 6 - visLen(temp); pad > 0; pad-- {
+		b.WriteByte(' ')
+	}
+	b.WriteString(temp)
+
+	// Rain probability column: 8 visible columns, right-aligned
+	for pad := 8 - visLen(pop); pad > 0; pad-- {
+		b.WriteByte(' ')
+	}
+	b.WriteString(pop)
+
+	// Condition and wind column
+	b.WriteString("  ")
+	b.WriteString(emoji)
+	if wind != "" {
+		b.WriteByte(' ')
+		b.WriteString(wind)
+	}
+
+	return b.String()
+}
+
+// sparkline renders values as a row of block characters scaled between their min and max.
+func sparkline(values []float64) string {
+	lo, hi := minMax(values)
+
+	var b strings.Builder
+	for _, v := range values {
+		idx := 0
+		if hi > lo {
+			idx = int((v - lo) / (hi - lo) * float64(len(sparkBlocks)-1))
+		}
+		b.WriteRune(sparkBlocks[idx])
+	}
+	return b.String()
+}
+
+// minMax returns the smallest and largest of the given values (0, 0 if empty).
+func minMax(values []float64) (lo, hi float64) {
+	if len(values) == 0 {
+		return 0, 0
+	}
+	lo, hi = values[0], values[0]
+	for _, v := range values {
+		if v < lo {
+			lo = v
+		}
+		if v > hi {
+			hi = v
+		}
+	}
+	return lo, hi
+}
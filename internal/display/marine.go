@@ -0,0 +1,100 @@
+package display
+
+import (
+	"fmt"
+	"goweather/internal/i18n"
+	"goweather/internal/units"
+	"goweather/internal/weather"
+	"strings"
+)
+
+// RenderMarineCard produces the terminal output for the marine view:
+// current conditions, current sea state and a daily wave forecast.
+func RenderMarineCard(loc string, data *weather.WeatherData, imperial bool, days int) string {
+	var b strings.Builder
+
+	writeCurrent(&b, loc, data, imperial)
+
+	m := data.Marine
+	seaTemp := m.Current.SeaSurfaceTemperature
+	if imperial {
+		seaTemp = units.CelsiusToFahrenheit(seaTemp)
+	}
+
+	// Current sea state: label(8) then values
+	b.WriteString(padLine(marineLine(i18n.Label("waves"), fmt.Sprintf("%s %s  %s",
+		Cyan(units.FormatHeight(m.Current.WaveHeight, imperial)),
+		units.WindCardinal(m.Current.WaveDirection),
+		formatPeriod(m.Current.WavePeriod)))))
+	b.WriteString(padLine(marineLine(i18n.Label("swell"), fmt.Sprintf("%s %s  %s",
+		Cyan(units.FormatHeight(m.Current.SwellWaveHeight, imperial)),
+		units.WindCardinal(m.Current.SwellWaveDirection),
+		formatPeriod(m.Current.SwellWavePeriod)))))
+	b.WriteString(padLine(marineLine(i18n.Label("sea"), Yellow(units.FormatTemp(seaTemp, imperial)))))
+	b.WriteString(emptyLine())
+
+	// Columns: Day(8) Waves(8) Dir(6) Period(8) Swell(8)
+	b.WriteString(padLine(marineRow(Dim(i18n.Label("day")), Dim(i18n.Label("waves")), Dim(i18n.Label("dir")), Dim(i18n.Label("period")), Dim(i18n.Label("swell")))))
+
+	limit := days
+	if limit > len(m.Daily) {
+		limit = len(m.Daily)
+	}
+	for _, d := range m.Daily[:limit] {
+		b.WriteString(padLine(marineRow(
+			i18n.FormatDay(d.Date),
+			units.FormatHeight(d.WaveHeightMax, imperial),
+			units.WindCardinal(d.WaveDirectionDominant),
+			formatPeriod(d.WavePeriodMax),
+			units.FormatHeight(d.SwellWaveHeightMax, imperial),
+		)))
+	}
+
+	writeExtras(&b, data, imperial)
+
+	b.WriteString(bottomBorder())
+
+	return b.String()
+}
+
+// formatPeriod formats a wave period in seconds.
+func formatPeriod(seconds float64) string {
+	return fmt.Sprintf("%.0fs", seconds)
+}
+
+// marineLine builds a current sea state line with a fixed-width label.
+func marineLine(label, value string) string {
+	var b strings.Builder
+	b.WriteString("  ")
+	b.WriteString(label)
+	for pad := 12 - visLen(label); pad > 0; pad-- {
+		b.WriteByte(' ')
+	}
+	b.WriteString(value)
+	return b.String()
+}
+
+// marineRow builds a marine forecast row with fixed column widths.
+func marineRow(day, waves, dir, period, swell string) string {
+	var b strings.Builder
+	b.WriteString("  ")
+
+	// Day column: 8 visible columns
+	b.WriteString(day)
+	for pad := 8 - visLen(day); pad > 0; pad-- {
+		b.WriteByte(' ')
+	}
+
+	// Remaining columns are right-aligned
+	for _, col := range []struct {
+		s     string
+		width int
+	}{{waves, 8}, {dir, 6}, {period, 8}, {swell, 11}} {
+		for pad := col.width - visLen(col.s); pad > 0; pad-- {
+			b.WriteByte(' ')
+		}
+		b.WriteString(col.s)
+	}
+
+	return b.String()
+}
@@ -0,0 +1,155 @@
+package display
+
+import (
+	"fmt"
+	"goweather/internal/i18n"
+	"goweather/internal/units"
+	"goweather/internal/weather"
+	"strings"
+)
+
+// RenderModelsCard produces the terminal output for the model comparison view:
+// current conditions and, per day, one row per weather model with the spread
+// between the warmest and coldest model.
+func RenderModelsCard(loc string, data *weather.WeatherData, models []weather.ModelForecast, imperial bool, days int) string {
+	var b strings.Builder
+
+	writeCurrent(&b, loc, data, imperial)
+
+	daily := data.Forecast()
+	if days < len(daily) {
+		daily = daily[:days]
+	}
+
+	// Columns: Model(14) Hi(6) Lo(6) Precip(8) Cond(rest)
+	b.WriteString(padLine(modelRow(Dim(i18n.Label("model")), Dim(i18n.Label("hi")), Dim(i18n.Label("lo")), Dim(i18n.Label("precip")), Dim(i18n.Label("cond")), "")))
+
+	for i, d := range daily {
+		if i > 0 {
+			b.WriteString(emptyLine())
+		}
+
+		var forecasts []weather.DailyForecast
+		for _, m := range models {
+			if f, ok := modelDay(m, d.Date); ok {
+				forecasts = append(forecasts, f)
+			}
+		}
+		line := "  " + Bold(i18n.FormatDay(d.Date))
+		if len(forecasts) > 1 {
+			line += "  " + formatSpread(modelSpread(forecasts), imperial)
+		}
+		b.WriteString(padLine(line))
+
+		for _, m := range models {
+			name := "  " + weather.ModelLabel(m.Model)
+			f, ok := modelDay(m, d.Date)
+			if !ok {
+				b.WriteString(padLine(modelRow(Dim(name), Dim("–"), Dim("–"), "", "", "")))
+				continue
+			}
+			fc := GetCondition(f.WeatherCode)
+			b.WriteString(padLine(modelRow(
+				name,
+				units.FormatTemp(f.TemperatureMax, imperial),
+				units.FormatTemp(f.TemperatureMin, imperial),
+				units.FormatPrecip(f.PrecipitationSum, imperial),
+				fc.Emoji,
+				fc.Description,
+			)))
+		}
+	}
+
+	writeExtras(&b, data, imperial)
+
+	b.WriteString(bottomBorder())
+
+	return b.String()
+}
+
+// modelDay returns the model's forecast for the given date, if it has one.
+func modelDay(m weather.ModelForecast, date string) (weather.DailyForecast, bool) {
+	for _, d := range m.Daily {
+		if d.Date == date {
+			return d, true
+		}
+	}
+	return weather.DailyForecast{}, false
+}
+
+// modelSpread returns the larger of the max and min temperature ranges across models.
+func modelSpread(forecasts []weather.DailyForecast) float64 {
+	maxes := make([]float64, len(forecasts))
+	mins := make([]float64, len(forecasts))
+	for i, f := range forecasts {
+		maxes[i] = f.TemperatureMax
+		mins[i] = f.TemperatureMin
+	}
+	loMax, hiMax := minMax(maxes)
+	loMin, hiMin := minMax(mins)
+	if hiMin-loMin > hiMax-loMax {
+		return hiMin - loMin
+	}
+	return hiMax - loMax
+}
+
+// spreadThreshold returns the model spread (in the active units) from which the
+// models are considered to disagree.
+func spreadThreshold(imperial bool) float64 {
+	if imperial {
+		return 5
+	}
+	return 3
+}
+
+// formatSpread formats the model spread, yellow when the models disagree and
+// red when they disagree strongly.
+func formatSpread(spread float64, imperial bool) string {
+	s := fmt.Sprintf("%s %.0f°", i18n.Label("spread"), spread)
+	switch {
+	case spread >= 2*spreadThreshold(imperial):
+		return Red(s)
+	case spread >= spreadThreshold(imperial):
+		return Yellow(s)
+	default:
+		return Dim(s)
+	}
+}
+
+// modelRow builds a model comparison row with fixed column widths.
+func modelRow(model, hi, lo, precip, emoji, desc string) string {
+	var b strings.Builder
+	b.WriteString("  ")
+
+	// Model column: 14 visible columns (fits "  Météo-France")
+	b.WriteString(model)
+	for pad := 14 - visLen(model); pad > 0; pad-- {
+		b.WriteByte(' ')
+	}
+
+	// Hi and Lo columns: 6 visible columns each, right-aligned
+	for _, s := range []string{hi, lo} {
+		for pad := 6 - visLen(s); pad > 0; pad-- {
+			b.WriteByte(' ')
+		}
+		b.WriteString(s)
+	}
+
+	// Precipitation column: 8 visible columns, right-aligned
+	for pad := 8 - visLen(precip); pad > 0; pad-- {
+		b.WriteByte(' ')
+	}
+	b.WriteString(precip)
+
+	// Condition column
+	if emoji != "" {
+		b.WriteString("  ")
+		b.WriteString(emoji)
+	}
+	if desc != "" {
+		b.WriteByte(' ')
+		b.WriteString(desc)
+	}
+
+	return b.String()
+}
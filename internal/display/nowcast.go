@@ -0,0 +1,147 @@
+package display
+
+import (
+	"goweather/internal/i18n"
+	"goweather/internal/units"
+	"goweather/internal/weather"
+	"strings"
+	"time"
+)
+
+// nowcastBarWidth is the width of the longest precipitation bar in columns.
+const nowcastBarWidth = 30
+
+// RenderNowcastCard produces the terminal output for the nowcast view: current
+// conditions, the precipitation outlook and a bar chart of the next two hours
+// in 15-minute steps.
+func RenderNowcastCard(loc string, data *weather.WeatherData, imperial bool) string {
+	var b strings.Builder
+
+	writeCurrent(&b, loc, data, imperial)
+
+	n := data.Nowcast
+	startsIn, stopsAt := nowcastOutlook(n, imperial)
+	summary := i18n.Nowcast(startsIn, stopsAt)
+	if startsIn >= 0 {
+		summary = Blue(summary)
+	}
+	b.WriteString(padLine("  " + summary))
+	b.WriteString(emptyLine())
+
+	steps := nowcastWindow(n)
+	// Scale bars to the heaviest step, but at least to 1 mm (0.04 in) so that
+	// drizzle does not fill the chart.
+	scale := 1.0
+	if imperial {
+		scale = 0.04
+	}
+	for _, s := range steps {
+		if s.Precipitation > scale {
+			scale = s.Precipitation
+		}
+	}
+
+	// Columns: Time(8) Bar(30) Amount(rest)
+	for _, s := range steps {
+		cells := int(s.Precipitation/scale*nowcastBarWidth + 0.5)
+		if cells == 0 && s.Precipitation >= nowcastThreshold(imperial) {
+			cells = 1
+		}
+		bar := Blue(strings.Repeat("█", cells)) + strings.Repeat(" ", nowcastBarWidth-cells)
+		amount := units.FormatPrecip(s.Precipitation, imperial)
+		if s.Precipitation < nowcastThreshold(imperial) {
+			amount = Dim(amount)
+		}
+
+		var line strings.Builder
+		clock := i18n.FormatTime(s.Time)
+		line.WriteString("  ")
+		line.WriteString(clock)
+		for pad := 8 - visLen(clock); pad > 0; pad-- {
+			line.WriteByte(' ')
+		}
+		line.WriteString(bar)
+		line.WriteString("  ")
+		line.WriteString(amount)
+		b.WriteString(padLine(line.String()))
+	}
+
+	writeExtras(&b, data, imperial)
+
+	b.WriteString(bottomBorder())
+
+	return b.String()
+}
+
+// writeNowcastSummary writes the precipitation outlook followed by a divider
+// when rain is falling or expected within two hours, and nothing otherwise.
+func writeNowcastSummary(b *strings.Builder, n *weather.Nowcast, imperial bool) {
+	if n == nil {
+		return
+	}
+	startsIn, stopsAt := nowcastOutlook(n, imperial)
+	if startsIn < 0 {
+		return
+	}
+	b.WriteString(padLine("  ☔ " + Blue(i18n.Nowcast(startsIn, stopsAt))))
+	b.WriteString(divider())
+}
+
+// nowcastThreshold returns the 15-minute precipitation amount (in the active
+// units) from which a step counts as rain.
+func nowcastThreshold(imperial bool) float64 {
+	if imperial {
+		return 0.004
+	}
+	return 0.1
+}
+
+// nowcastWindow returns the steps ending after the nowcast time and at most
+// two hours later.
+func nowcastWindow(n *weather.Nowcast) []weather.NowcastStep {
+	if n == nil {
+		return nil
+	}
+	now, err := time.Parse("2006-01-02T15:04", n.Time)
+	if err != nil {
+		return n.Steps
+	}
+	var steps []weather.NowcastStep
+	for _, s := range n.Steps {
+		t, err := time.Parse("2006-01-02T15:04", s.Time)
+		if err != nil || !t.After(now) || t.Sub(now) > 2*time.Hour {
+			continue
+		}
+		steps = append(steps, s)
+	}
+	return steps
+}
+
+// nowcastOutlook returns the minutes until rain starts (0 if the first step
+// is wet, negative if no rain is expected) and the time the first spell of
+// rain stops (empty if it lasts beyond the window), as used by i18n.Nowcast.
+func nowcastOutlook(n *weather.Nowcast, imperial bool) (int, string) {
+	if n == nil {
+		return -1, ""
+	}
+	steps := nowcastWindow(n)
+	now, _ := time.Parse("2006-01-02T15:04", n.Time)
+
+	startsIn := -1
+	for i, s := range steps {
+		wet := s.Precipitation >= nowcastThreshold(imperial)
+		switch {
+		case startsIn < 0 && wet:
+			// Steps hold the preceding 15 minutes, so rain starts at the step's beginning
+			if t, err := time.Parse("2006-01-02T15:04", s.Time); err == nil {
+				startsIn = int(t.Add(-15 * time.Minute).Sub(now).Minutes())
+			}
+			if startsIn < 0 {
+				startsIn = 0
+			}
+		case startsIn >= 0 && !wet:
+			return startsIn, steps[i-1].Time
+		}
+	}
+	return startsIn, ""
+}
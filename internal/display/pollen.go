@@ -0,0 +1,90 @@
+package display
+
+import (
+	"fmt"
+	"goweather/internal/i18n"
+	"goweather/internal/weather"
+	"strings"
+)
+
+const (
+	pollenNameWidth = 11
+	pollenCellWidth = 7
+)
+
+// pollenThresholds holds the concentrations (grains/m³) at which each plant's
+// pollen load becomes moderate and high, indexed like weather.PollenTypes.
+var pollenThresholds = [6][2]float64{
+	{11, 101}, // alder
+	{11, 101}, // birch
+	{20, 50},  // grass
+	{10, 50},  // mugwort
+	{11, 101}, // olive
+	{10, 50},  // ragweed
+}
+
+// pollenCell formats a pollen concentration colored by its load level.
+func pollenCell(idx int, v float64) string {
+	s := fmt.Sprintf("%.0f", v)
+	switch {
+	case v < 1:
+		return Dim("-")
+	case v < pollenThresholds[idx][0]:
+		return Green(s)
+	case v < pollenThresholds[idx][1]:
+		return Yellow(s)
+	default:
+		return Red(s)
+	}
+}
+
+// writePollen writes the pollen section (one row per plant, one column per
+// day) preceded by a divider.
+func writePollen(b *strings.Builder, pollen *weather.PollenForecast) {
+	b.WriteString(divider())
+
+	if !pollen.Available {
+		b.WriteString(padLine("  " + Dim(i18n.PollenUnavailable())))
+		return
+	}
+
+	days := pollen.Daily
+	if maxDays := (cardWidth - 2 - pollenNameWidth) / pollenCellWidth; len(days) > maxDays {
+		days = days[:maxDays]
+	}
+
+	header := make([]string, len(days))
+	for i, d := range days {
+		header[i] = Dim(i18n.FormatDay(d.Date))
+	}
+	b.WriteString(padLine(pollenRow(Dim(i18n.Label("pollen")), header)))
+
+	for p := range weather.PollenTypes {
+		cells := make([]string, len(days))
+		for i, d := range days {
+			cells[i] = pollenCell(p, d.Levels[p])
+		}
+		b.WriteString(padLine(pollenRow(i18n.PollenName(p), cells)))
+	}
+	b.WriteString(padLine(Dim("  grains/m³")))
+}
+
+// pollenRow builds a pollen table row: a name column followed by right-aligned day cells.
+func pollenRow(name string, cells []string) string {
+	var b strings.Builder
+	b.WriteString("  ")
+
+	b.WriteString(name)
+	for pad := pollenNameWidth - visLen(name); pad > 0; pad-- {
+		b.WriteByte(' ')
+	}
+
+	for _, c := range cells {
+		for pad := pollenCellWidth - visLen(c); pad > 0; pad-- {
+			b.WriteByte(' ')
+		}
+		b.WriteString(c)
+	}
+
+	return b.String()
+}
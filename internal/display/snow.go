@@ -0,0 +1,96 @@
+package display
+
+import (
+	"fmt"
+	"goweather/internal/i18n"
+	"goweather/internal/units"
+	"goweather/internal/weather"
+	"strings"
+)
+
+// snowfallThreshold is the daily new snow in cm from which a day is highlighted.
+const snowfallThreshold = 1.0
+
+// RenderSnowCard produces the terminal output for the snow view: current
+// conditions, snow depth and freezing level, and the daily new snow with the
+// freezing level range.
+func RenderSnowCard(loc string, data *weather.WeatherData, imperial bool, days int) string {
+	var b strings.Builder
+
+	writeCurrent(&b, loc, data, imperial)
+
+	s := data.Snow
+	var now []string
+	if s.HasDepth {
+		now = append(now, fmt.Sprintf("%s %s", i18n.Label("snowdepth"), Cyan(units.FormatSnow(s.Depth, imperial))))
+	}
+	if s.HasFreezingLevel {
+		now = append(now, fmt.Sprintf("%s %s", i18n.Label("freezinglevel"), Cyan(units.FormatElevation(s.FreezingLevel, imperial))))
+	}
+	if len(now) > 0 {
+		b.WriteString(padLine("  " + strings.Join(now, "  ")))
+		b.WriteString(emptyLine())
+	}
+
+	// Columns: Day(8) New snow(12) Freezing level(rest)
+	b.WriteString(padLine(snowRow(Dim(i18n.Label("day")), Dim(i18n.Label("newsnow")), Dim(i18n.Label("freezinglevel")))))
+
+	limit := days
+	if limit > len(s.Daily) {
+		limit = len(s.Daily)
+	}
+	for _, d := range s.Daily[:limit] {
+		snowfall := units.FormatSnow(d.Snowfall, imperial)
+		if d.Snowfall >= snowfallThreshold {
+			snowfall = Bold(Cyan(snowfall))
+		} else {
+			snowfall = Dim(snowfall)
+		}
+		level := Dim("–")
+		if d.HasFreezingLevel {
+			level = formatLevelRange(d.FreezingLevelMin, d.FreezingLevelMax, imperial)
+		}
+		b.WriteString(padLine(snowRow(i18n.FormatDay(d.Date), snowfall, level)))
+	}
+
+	writeExtras(&b, data, imperial)
+
+	b.WriteString(bottomBorder())
+
+	return b.String()
+}
+
+// formatLevelRange formats a height range in meters, e.g. "900–1300 m", or a
+// single height when both ends round to the same value.
+func formatLevelRange(low, high float64, imperial bool) string {
+	unit := " " + units.HeightUnit(imperial)
+	lo := strings.TrimSuffix(units.FormatElevation(low, imperial), unit)
+	hi := units.FormatElevation(high, imperial)
+	if lo+unit == hi {
+		return hi
+	}
+	return lo + "–" + hi
+}
+
+// snowRow builds a snow forecast row with fixed column widths.
+func snowRow(day, snowfall, level string) string {
+	var b strings.Builder
+	b.WriteString("  ")
+
+	// Day column: 8 visible columns
+	b.WriteString(day)
+	for pad := 8 - visLen(day); pad > 0; pad-- {
+		b.WriteByte(' ')
+	}
+
+	// New snow column: 12 visible columns, right-aligned
+	for pad := 12 - visLen(snowfall); pad > 0; pad-- {
+		b.WriteByte(' ')
+	}
+	b.WriteString(snowfall)
+
+	b.WriteString("    ")
+	b.WriteString(level)
+
+	return b.String()
+}
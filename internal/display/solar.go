@@ -0,0 +1,94 @@
+package display
+
+import (
+	"fmt"
+	"goweather/internal/i18n"
+	"goweather/internal/solar"
+	"goweather/internal/units"
+	"strings"
+)
+
+// solarBarWidth is the width of the longest yield bar in columns.
+const solarBarWidth = 28
+
+// RenderSolarCard produces the terminal output for the solar view: the
+// estimated energy per day and today's hourly output curve for a PV panel.
+func RenderSolarCard(loc string, panel solar.Panel, hourly []solar.HourlyYield, daily []solar.DailyYield) string {
+	var b strings.Builder
+
+	b.WriteString(topBorder())
+
+	// Header: location + panel
+	header := fmt.Sprintf("  %s  ☀️ %s", Bold(loc), Dim(fmt.Sprintf("%.1f kWp · %.0f° · %s",
+		panel.KWp, panel.Tilt, units.WindCardinal(int(panel.Azimuth+0.5)))))
+	b.WriteString(padLine(header))
+	b.WriteString(emptyLine())
+
+	// Columns: Day(10) Bar(28) Energy(rest)
+	b.WriteString(padLine(solarRow(Dim(i18n.Label("day")), "", Dim(i18n.Label("energy")))))
+	var maxEnergy float64
+	for _, d := range daily {
+		if d.Energy > maxEnergy {
+			maxEnergy = d.Energy
+		}
+	}
+	for _, d := range daily {
+		b.WriteString(padLine(solarRow(i18n.FormatDay(d.Date),
+			solarBar(d.Energy, maxEnergy), fmt.Sprintf("%.1f kWh", d.Energy))))
+	}
+
+	// Today's curve, daylight hours only
+	if len(daily) > 0 {
+		today := daily[0].Date
+		var hours []solar.HourlyYield
+		var maxPower float64
+		for _, h := range hourly {
+			if !strings.HasPrefix(h.Time, today) || h.Power < 0.005 {
+				continue
+			}
+			hours = append(hours, h)
+			if h.Power > maxPower {
+				maxPower = h.Power
+			}
+		}
+		if len(hours) > 0 {
+			b.WriteString(divider())
+			b.WriteString(padLine(solarRow(Dim(i18n.Label("time")), "", Dim("kW"))))
+			for _, h := range hours {
+				b.WriteString(padLine(solarRow(i18n.FormatTime(h.Time),
+					solarBar(h.Power, maxPower), fmt.Sprintf("%.2f", h.Power))))
+			}
+		}
+	}
+
+	b.WriteString(bottomBorder())
+
+	return b.String()
+}
+
+// solarBar returns a yellow bar for value scaled so that max fills the bar
+// width, padded to the full width.
+func solarBar(value, max float64) string {
+	cells := 0
+	if max > 0 {
+		cells = int(value/max*solarBarWidth + 0.5)
+	}
+	return Yellow(strings.Repeat("█", cells)) + strings.Repeat(" ", solarBarWidth-cells)
+}
+
+// solarRow builds a solar yield row with fixed column widths.
+func solarRow(label, bar, value string) string {
+	var b strings.Builder
+	b.WriteString("  ")
+	b.WriteString(label)
+	for pad := 10 - visLen(label); pad > 0; pad-- {
+		b.WriteByte(' ')
+	}
+	b.WriteString(bar)
+	for pad := solarBarWidth - visLen(bar); pad > 0; pad-- {
+		b.WriteByte(' ')
+	}
+	b.WriteString("  ")
+	b.WriteString(value)
+	return b.String()
+}
@@ -0,0 +1,30 @@
+package display
+
+import (
+	"goweather/internal/i18n"
+	"strings"
+	"time"
+)
+
+// StaleAsOf is when the data shown was fetched, if it comes from the cache
+// instead of the network; zero for fresh data.
+var StaleAsOf time.Time
+
+// writeStale writes a banner for cached data, followed by a divider.
+func writeStale(b *strings.Builder, now time.Time) {
+	if StaleAsOf.IsZero() {
+		return
+	}
+	b.WriteString(padLine("  " + Yellow(Bold("⚠ "+i18n.Stale(formatAsOf(StaleAsOf, now))))))
+	b.WriteString(divider())
+}
+
+// formatAsOf formats t as a clock time, prefixed with the day unless it is
+// the same day as now.
+func formatAsOf(t, now time.Time) string {
+	clock := i18n.FormatTime(t.Format("2006-01-02T15:04"))
+	if t.Format("2006-01-02") == now.Format("2006-01-02") {
+		return clock
+	}
+	return i18n.FormatDay(t.Format("2006-01-02")) + " " + clock
+}
@@ -0,0 +1,46 @@
+package display
+
+import (
+	"fmt"
+	"goweather/internal/i18n"
+	"math"
+)
+
+// uvLevel maps a UV index to its WHO risk category:
+// 0 low (0-2), 1 moderate (3-5), 2 high (6-7), 3 very high (8-10), 4 extreme (11+).
+func uvLevel(uv float64) int {
+	switch r := math.Round(uv); {
+	case r <= 2:
+		return 0
+	case r <= 5:
+		return 1
+	case r <= 7:
+		return 2
+	case r <= 10:
+		return 3
+	default:
+		return 4
+	}
+}
+
+// uvColor applies the WHO category color (green, yellow, orange, red, violet).
+func uvColor(level int, s string) string {
+	switch level {
+	case 0:
+		return Green(s)
+	case 1:
+		return Yellow(s)
+	case 2:
+		return Orange(s)
+	case 3:
+		return Red(s)
+	default:
+		return Magenta(s)
+	}
+}
+
+// FormatUV formats a UV index with its translated, color-coded WHO category.
+func FormatUV(uv float64) string {
+	level := uvLevel(uv)
+	return uvColor(level, fmt.Sprintf("%.0f %s", uv, i18n.UVCategory(level)))
+}
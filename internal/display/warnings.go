@@ -0,0 +1,41 @@
+package display
+
+import (
+	"fmt"
+	"goweather/internal/i18n"
+	"goweather/internal/units"
+	"goweather/internal/weather"
+	"strings"
+)
+
+// writeWarnings writes the red warning banner (one line per warning)
+// followed by a divider. Nothing is written when there are no warnings.
+func writeWarnings(b *strings.Builder, warns []weather.Warning, imperial bool) {
+	if len(warns) == 0 {
+		return
+	}
+
+	for _, w := range warns {
+		text := i18n.Warning(w.Kind)
+		if value := formatWarningValue(w, imperial); value != "" {
+			text += ": " + value
+		}
+		text += " (" + i18n.FormatDay(w.Date) + ")"
+		b.WriteString(padLine("  " + Red(Bold("⚠ "+text))))
+	}
+	b.WriteString(divider())
+}
+
+// formatWarningValue formats the triggering value of a warning in its unit.
+func formatWarningValue(w weather.Warning, imperial bool) string {
+	switch w.Kind {
+	case "heat", "frost":
+		return units.FormatTemp(w.Value, imperial)
+	case "gust":
+		return fmt.Sprintf("%.0f %s", w.Value, units.WindUnit(imperial))
+	case "precip":
+		return units.FormatPrecip(w.Value, imperial)
+	default:
+		return ""
+	}
+}
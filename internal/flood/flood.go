@@ -0,0 +1,163 @@
+// Package flood compares river discharge forecasts with the historical record
+// and classifies the flood risk.
+package flood
+
+import (
+	"context"
+	"fmt"
+	"goweather/internal/cache"
+	"goweather/internal/weather"
+	"sort"
+)
+
+// windowDays is the half-width of the window (in calendar days) pooled around
+// each day, so that every day's statistics rest on a month of each year.
+const windowDays = 15
+
+// Risk classification thresholds.
+const (
+	elevatedRatio = 2    // discharge at least this multiple of the median
+	highShare     = 0.75 // share of the way from the median to the maximum
+)
+
+// Risk levels, in increasing order of severity.
+const (
+	RiskNormal   = "normal"
+	RiskElevated = "elevated"
+	RiskHigh     = "high"
+	RiskSevere   = "severe" // at or above the highest discharge on record
+)
+
+// severity orders the risk levels.
+var severity = map[string]int{RiskNormal: 0, RiskElevated: 1, RiskHigh: 2, RiskSevere: 3}
+
+// Stats holds the median and maximum discharge (m³/s) of the reference period
+// for each calendar day, indexed by cache.DayIndex, with the number of values they
+// rest on.
+type Stats struct {
+	Median [366]float64
+	Max    [366]float64
+	Count  [366]int
+}
+
+// Day is one forecast day compared with the record for its time of year.
+type Day struct {
+	Date      string
+	Discharge float64
+	Median    float64
+	Max       float64
+	Risk      string
+}
+
+// Get returns the discharge statistics for a location, computing them from
+// the record of the reference period on first use and caching them on disk
+// afterwards. 30 years of daily data can take a while to serve, so ctx should
+// allow for a longer deadline.
+func Get(ctx context.Context, client *weather.FloodClient, lat, lon float64) (*Stats, error) {
+	path := cachePath(lat, lon)
+	var cached Stats
+	if err := cache.ReadJSON(path, &cached); err == nil {
+		return &cached, nil
+	}
+
+	history, err := client.FetchDischargeHistory(ctx, lat, lon, cache.RecordStart, cache.RecordEnd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch discharge record: %w", err)
+	}
+
+	s := Compute(history)
+	_ = cache.WriteJSON(path, s)
+	return s, nil
+}
+
+// Compute returns the median and maximum discharge per calendar day, pooling
+// the days within ±windowDays of it across all years.
+func Compute(history []weather.DailyDischarge) *Stats {
+	var byDay [366][]float64
+	for _, d := range history {
+		idx, ok := cache.DayIndex(d.Date)
+		if !ok {
+			continue
+		}
+		byDay[idx] = append(byDay[idx], d.Discharge)
+	}
+
+	s := &Stats{}
+	for i := range s.Median {
+		var values []float64
+		for off := -windowDays; off <= windowDays; off++ {
+			values = append(values, byDay[(i+off+366)%366]...)
+		}
+		if len(values) == 0 {
+			continue
+		}
+		sort.Float64s(values)
+		s.Median[i] = median(values)
+		s.Max[i] = values[len(values)-1]
+		s.Count[i] = len(values)
+	}
+	return s
+}
+
+// Outlook compares each forecast day with the statistics for its date. Days
+// without statistics are classified as normal.
+func Outlook(forecast []weather.DailyDischarge, s *Stats) []Day {
+	days := make([]Day, len(forecast))
+	for i, f := range forecast {
+		days[i] = Day{Date: f.Date, Discharge: f.Discharge, Risk: RiskNormal}
+		idx, ok := cache.DayIndex(f.Date)
+		if !ok || s.Count[idx] == 0 {
+			continue
+		}
+		days[i].Median = s.Median[idx]
+		days[i].Max = s.Max[idx]
+		days[i].Risk = Classify(f.Discharge, s.Median[idx], s.Max[idx])
+	}
+	return days
+}
+
+// Classify returns the risk level of a discharge given the median and maximum
+// for the time of year: severe at or above the maximum, high from three
+// quarters of the way between median and maximum, elevated from twice the
+// median.
+func Classify(discharge, median, max float64) string {
+	switch {
+	case max > 0 && discharge >= max:
+		return RiskSevere
+	case max > median && discharge >= median+highShare*(max-median):
+		return RiskHigh
+	case median > 0 && discharge >= elevatedRatio*median:
+		return RiskElevated
+	default:
+		return RiskNormal
+	}
+}
+
+// Worst returns the first day with the highest risk, or false if there are no
+// days.
+func Worst(days []Day) (Day, bool) {
+	if len(days) == 0 {
+		return Day{}, false
+	}
+	worst := days[0]
+	for _, d := range days[1:] {
+		if severity[d.Risk] > severity[worst.Risk] {
+			worst = d
+		}
+	}
+	return worst, true
+}
+
+// cachePath returns the cache file of the discharge statistics for a location.
+func cachePath(lat, lon float64) string {
+	return cache.Path("discharge", cache.CoordKey(lat, lon)+".json")
+}
+
+// median returns the median of sorted values.
+func median(sorted []float64) float64 {
+	n := len(sorted)
+	if n%2 == 1 {
+		return sorted[n/2]
+	}
+	return (sorted[n/2-1] + sorted[n/2]) / 2
+}
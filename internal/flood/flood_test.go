@@ -0,0 +1,142 @@
+package flood
+
+import (
+	"context"
+	"goweather/internal/cache"
+	"goweather/internal/weather"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// history returns three years of daily discharge of 90, 100 and 110 m³/s,
+// with a single flood peak of 500 m³/s on 2019-02-14.
+func history() []weather.DailyDischarge {
+	var days []weather.DailyDischarge
+	for year, discharge := range map[int]float64{2018: 90, 2019: 100, 2020: 110} {
+		for t := time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC); t.Year() == year; t = t.AddDate(0, 0, 1) {
+			days = append(days, weather.DailyDischarge{Date: t.Format("2006-01-02"), Discharge: discharge})
+		}
+	}
+	days = append(days, weather.DailyDischarge{Date: "2019-02-14", Discharge: 500})
+	return days
+}
+
+func TestCompute(t *testing.T) {
+	s := Compute(history())
+
+	tests := []struct {
+		date        string
+		median, max float64
+	}{
+		{"2026-02-14", 100, 500},
+		// Still within the window of the peak
+		{"2026-02-28", 100, 500},
+		{"2026-07-01", 100, 110},
+		// The window wraps around the turn of the year
+		{"2026-01-01", 100, 110},
+	}
+	for _, tt := range tests {
+		idx, _ := cache.DayIndex(tt.date)
+		if s.Median[idx] != tt.median || s.Max[idx] != tt.max {
+			t.Errorf("%s: median %.0f, max %.0f, want %.0f and %.0f", tt.date, s.Median[idx], s.Max[idx], tt.median, tt.max)
+		}
+	}
+
+	// 31 days of three years, less Feb 29 outside the leap year, plus the peak
+	idx, _ := cache.DayIndex("2026-02-14")
+	if s.Count[idx] != 92 {
+		t.Errorf("count = %d, want 92", s.Count[idx])
+	}
+}
+
+func TestClassify(t *testing.T) {
+	tests := []struct {
+		discharge, median, max float64
+		want                   string
+	}{
+		{150, 100, 500, RiskNormal},
+		{200, 100, 500, RiskElevated},
+		{399, 100, 500, RiskElevated},
+		{400, 100, 500, RiskHigh},
+		{500, 100, 500, RiskSevere},
+		{620, 100, 500, RiskSevere},
+		// A regulated river rarely doubles; nearing its maximum is high
+		{140, 100, 150, RiskHigh},
+		{20, 0, 0, RiskNormal},
+	}
+	for _, tt := range tests {
+		if got := Classify(tt.discharge, tt.median, tt.max); got != tt.want {
+			t.Errorf("Classify(%.0f, %.0f, %.0f) = %q, want %q", tt.discharge, tt.median, tt.max, got, tt.want)
+		}
+	}
+}
+
+func TestOutlook(t *testing.T) {
+	s := Compute(history())
+	days := Outlook([]weather.DailyDischarge{
+		{Date: "2026-02-14", Discharge: 120},
+		{Date: "2026-02-15", Discharge: 420},
+		{Date: "2026-02-16", Discharge: 260},
+		{Date: "2026-02-17", Discharge: 510},
+		{Date: "soon", Discharge: 900},
+	}, s)
+
+	want := []string{RiskNormal, RiskHigh, RiskElevated, RiskSevere, RiskNormal}
+	for i, d := range days {
+		if d.Risk != want[i] {
+			t.Errorf("days[%d] risk = %q, want %q", i, d.Risk, want[i])
+		}
+	}
+	if days[1].Median != 100 || days[1].Max != 500 {
+		t.Errorf("days[1] = %+v, want median 100 and max 500", days[1])
+	}
+
+	worst, ok := Worst(days)
+	if !ok || worst.Date != "2026-02-17" {
+		t.Errorf("worst = %+v, want Feb 17", worst)
+	}
+	worst, _ = Worst(days[:3])
+	if worst.Date != "2026-02-15" {
+		t.Errorf("worst of the first three = %+v, want Feb 15", worst)
+	}
+	if _, ok := Worst(nil); ok {
+		t.Error("Worst(nil) should report no day")
+	}
+}
+
+func TestGetCachesStats(t *testing.T) {
+	old := cache.Dir
+	cache.Dir = t.TempDir()
+	defer func() { cache.Dir = old }()
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"daily":{"time":["2001-02-14","2002-02-14","2003-02-14"],` +
+			`"river_discharge":[90,100,110]}}`))
+	}))
+	defer server.Close()
+
+	client := &weather.FloodClient{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+	}
+
+	idx, _ := cache.DayIndex("2026-02-14")
+	for i := 0; i < 2; i++ {
+		s, err := Get(context.Background(), client, 47.37, 8.54)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if s.Median[idx] != 100 || s.Max[idx] != 110 {
+			t.Errorf("median %.0f, max %.0f, want 100 and 110", s.Median[idx], s.Max[idx])
+		}
+	}
+
+	if requests != 1 {
+		t.Errorf("flood requests = %d, want 1 (second call should hit the cache)", requests)
+	}
+}
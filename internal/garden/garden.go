@@ -0,0 +1,164 @@
+// Package garden computes the FAO-56 Penman-Monteith reference
+// evapotranspiration (ET₀) from daily forecasts, and from it a daily water
+// balance and a watering recommendation.
+package garden
+
+import (
+	"goweather/internal/weather"
+	"math"
+	"time"
+)
+
+// solarConstantDaily is the solar constant in MJ/m² per minute (FAO-56 eq. 21).
+const solarConstantDaily = 0.0820
+
+// stefanBoltzmann is the Stefan-Boltzmann constant in MJ/K⁴/m² per day.
+const stefanBoltzmann = 4.903e-9
+
+// windHeight is the height of the forecast wind speed in meters.
+const windHeight = 10
+
+// Thresholds of the watering recommendation.
+const (
+	minDeficit    = 5    // mm; smaller deficits are not worth watering
+	moistSoil     = 0.25 // m³/m³ in the root zone, about field capacity for loam
+	rootZoneDepth = 27   // cm; soil levels down to this depth feed shallow roots
+	rainLookahead = 2    // days of forecast rain counted against the deficit
+)
+
+// Day is the water balance of one day in mm: precipitation minus reference
+// evapotranspiration, negative when the soil dries out.
+type Day struct {
+	Date          string
+	Precipitation float64
+	ET0           float64
+	Balance       float64
+}
+
+// Advice kinds returned by Recommend.
+const (
+	AdviceWater = "water" // water today, Amount mm
+	AdviceRain  = "rain"  // enough rain is forecast to cover the deficit
+	AdviceMoist = "moist" // no significant deficit or the soil is moist
+)
+
+// Advice is a watering recommendation. Amount is the suggested amount of water
+// in mm (liters per m²) for AdviceWater, and zero otherwise.
+type Advice struct {
+	Kind   string
+	Amount float64
+}
+
+// ET0 returns the reference evapotranspiration of a day in mm (FAO-56 eq. 6),
+// for a grass surface at the given latitude (degrees) and elevation (meters).
+// The forecast must be metric: °C, percent, km/h at 10 m and MJ/m². Soil heat
+// flux is neglected, as is usual for daily steps. Days whose date cannot be
+// parsed return 0.
+func ET0(d weather.DailyForecast, lat, elevation float64) float64 {
+	date, err := time.Parse("2006-01-02", d.Date)
+	if err != nil {
+		return 0
+	}
+
+	tmean := (d.TemperatureMax + d.TemperatureMin) / 2
+
+	// Psychrometric constant from the atmospheric pressure (kPa/°C)
+	pressure := 101.3 * math.Pow((293-0.0065*elevation)/293, 5.26)
+	gamma := 0.665e-3 * pressure
+
+	// Slope of the saturation vapour pressure curve (kPa/°C)
+	delta := 4098 * saturationVapourPressure(tmean) / math.Pow(tmean+237.3, 2)
+
+	// Saturation and actual vapour pressure (kPa)
+	es := (saturationVapourPressure(d.TemperatureMax) + saturationVapourPressure(d.TemperatureMin)) / 2
+	ea := (saturationVapourPressure(d.TemperatureMin)*d.RelativeHumidityMax/100 +
+		saturationVapourPressure(d.TemperatureMax)*d.RelativeHumidityMin/100) / 2
+
+	// Wind speed at 2 m (m/s) from the 10 m forecast
+	u2 := d.WindSpeedMean / 3.6 * 4.87 / math.Log(67.8*windHeight-5.42)
+
+	// Net radiation: shortwave after the grass albedo, minus outgoing longwave
+	rs := d.ShortwaveRadiationSum
+	rso := (0.75 + 2e-5*elevation) * ExtraterrestrialRadiation(lat, date.YearDay())
+	relativeShortwave := 1.0
+	if rso > 0 {
+		relativeShortwave = math.Min(rs/rso, 1)
+	}
+	rns := 0.77 * rs
+	rnl := stefanBoltzmann * (math.Pow(d.TemperatureMax+273.16, 4) + math.Pow(d.TemperatureMin+273.16, 4)) / 2 *
+		(0.34 - 0.14*math.Sqrt(ea)) * (1.35*relativeShortwave - 0.35)
+	rn := rns - rnl
+
+	et0 := (0.408*delta*rn + gamma*900/(tmean+273)*u2*(es-ea)) / (delta + gamma*(1+0.34*u2))
+	return math.Max(et0, 0)
+}
+
+// ExtraterrestrialRadiation returns the daily radiation at the top of the
+// atmosphere in MJ/m² (FAO-56 eq. 21) for a latitude in degrees and a day of
+// the year.
+func ExtraterrestrialRadiation(lat float64, dayOfYear int) float64 {
+	phi := lat * math.Pi / 180
+	j := float64(dayOfYear)
+	dr := 1 + 0.033*math.Cos(2*math.Pi/365*j)
+	decl := 0.409 * math.Sin(2*math.Pi/365*j-1.39)
+	// Sunset hour angle, clamped for polar day and night
+	ws := math.Acos(math.Max(-1, math.Min(1, -math.Tan(phi)*math.Tan(decl))))
+	return 24 * 60 / math.Pi * solarConstantDaily * dr *
+		(ws*math.Sin(phi)*math.Sin(decl) + math.Cos(phi)*math.Cos(decl)*math.Sin(ws))
+}
+
+// WaterBalance returns the water balance of each day.
+func WaterBalance(daily []weather.DailyForecast, lat, elevation float64) []Day {
+	days := make([]Day, len(daily))
+	for i, d := range daily {
+		et0 := ET0(d, lat, elevation)
+		days[i] = Day{
+			Date:          d.Date,
+			Precipitation: d.PrecipitationSum,
+			ET0:           et0,
+			Balance:       d.PrecipitationSum - et0,
+		}
+	}
+	return days
+}
+
+// Recommend decides whether to water on the day at index today. The deficit
+// is the balance of the days up to and including today; it is forgiven when
+// the root zone is still moist, and reduced by the rain forecast for the next
+// days.
+func Recommend(days []Day, today int, soil []weather.SoilLevel) Advice {
+	var deficit float64
+	for i := 0; i <= today && i < len(days); i++ {
+		deficit -= days[i].Balance
+	}
+	if deficit < minDeficit {
+		return Advice{Kind: AdviceMoist}
+	}
+
+	var moisture float64
+	var levels int
+	for _, s := range soil {
+		if s.Depth > 0 && s.Depth <= rootZoneDepth {
+			moisture += s.Moisture
+			levels++
+		}
+	}
+	if levels > 0 && moisture/float64(levels) >= moistSoil {
+		return Advice{Kind: AdviceMoist}
+	}
+
+	var rain float64
+	for i := today + 1; i <= today+rainLookahead && i < len(days); i++ {
+		rain += days[i].Precipitation
+	}
+	if deficit-rain < minDeficit {
+		return Advice{Kind: AdviceRain}
+	}
+	return Advice{Kind: AdviceWater, Amount: deficit - rain}
+}
+
+// saturationVapourPressure returns the saturation vapour pressure in kPa at a
+// temperature in °C (FAO-56 eq. 11).
+func saturationVapourPressure(t float64) float64 {
+	return 0.6108 * math.Exp(17.27*t/(t+237.3))
+}
@@ -0,0 +1,115 @@
+package garden
+
+import (
+	"goweather/internal/weather"
+	"math"
+	"testing"
+)
+
+// brussels is FAO-56 Example 18: Uccle (Brussels), 6 July, 100 m above sea
+// level, with 10 km/h of wind measured at 10 m.
+var brussels = weather.DailyForecast{
+	Date:                  "2026-07-06",
+	TemperatureMax:        21.5,
+	TemperatureMin:        12.3,
+	RelativeHumidityMax:   84,
+	RelativeHumidityMin:   63,
+	WindSpeedMean:         10,
+	ShortwaveRadiationSum: 22.07,
+}
+
+func TestExtraterrestrialRadiation(t *testing.T) {
+	tests := []struct {
+		name string
+		lat  float64
+		day  int
+		want float64
+	}{
+		{"FAO-56 Example 8, 20°S on 3 September", -20, 246, 32.2},
+		{"FAO-56 Example 18, Brussels on 6 July", 50.8, 187, 41.09},
+		{"polar night", 80, 355, 0},
+	}
+	for _, tt := range tests {
+		if got := ExtraterrestrialRadiation(tt.lat, tt.day); math.Abs(got-tt.want) > 0.05 {
+			t.Errorf("%s: Ra = %.2f, want %.2f", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestET0(t *testing.T) {
+	// FAO-56 Example 18 gives 3.9 mm/day
+	if got := ET0(brussels, 50.8, 100); math.Abs(got-3.9) > 0.05 {
+		t.Errorf("Brussels ET0 = %.2f, want 3.9", got)
+	}
+
+	// Hot, dry and windy weather evaporates more
+	hot := brussels
+	hot.TemperatureMax, hot.TemperatureMin = 32, 19
+	hot.RelativeHumidityMax, hot.RelativeHumidityMin = 60, 25
+	hot.WindSpeedMean = 20
+	if got := ET0(hot, 50.8, 100); got < 6 {
+		t.Errorf("hot day ET0 = %.2f, want above 6", got)
+	}
+
+	// A dark, humid winter day never goes negative
+	winter := weather.DailyForecast{Date: "2026-12-21", TemperatureMax: 2, TemperatureMin: -3,
+		RelativeHumidityMax: 100, RelativeHumidityMin: 95, WindSpeedMean: 5, ShortwaveRadiationSum: 0.8}
+	if got := ET0(winter, 52.5, 34); got < 0 || got > 0.5 {
+		t.Errorf("winter ET0 = %.2f, want between 0 and 0.5", got)
+	}
+
+	if got := ET0(weather.DailyForecast{Date: "tomorrow"}, 50.8, 100); got != 0 {
+		t.Errorf("ET0 with an invalid date = %f, want 0", got)
+	}
+}
+
+func TestWaterBalance(t *testing.T) {
+	wet := brussels
+	wet.Date = "2026-07-07"
+	wet.PrecipitationSum = 12
+	days := WaterBalance([]weather.DailyForecast{brussels, wet}, 50.8, 100)
+	if len(days) != 2 || days[1].Date != "2026-07-07" {
+		t.Fatalf("days = %+v, want 2 in order", days)
+	}
+	if math.Abs(days[0].Balance+days[0].ET0) > 1e-9 || days[0].ET0 < 3.8 {
+		t.Errorf("dry day = %+v, want the balance to be minus ET0", days[0])
+	}
+	if math.Abs(days[1].Balance-(12-days[1].ET0)) > 1e-9 {
+		t.Errorf("wet day = %+v, want the balance to be precipitation minus ET0", days[1])
+	}
+}
+
+func TestRecommend(t *testing.T) {
+	dry := []Day{
+		{Date: "2026-07-04", Balance: -4},
+		{Date: "2026-07-05", Balance: -5},
+		{Date: "2026-07-06", Balance: -4.5},
+		{Date: "2026-07-07", Precipitation: 1},
+		{Date: "2026-07-08", Precipitation: 2},
+		{Date: "2026-07-09", Precipitation: 20},
+	}
+	drySoil := []weather.SoilLevel{{Depth: 0, Moisture: 0.3}, {Depth: 6, Moisture: 0.12}, {Depth: 18, Moisture: 0.18}}
+	moistSoil := []weather.SoilLevel{{Depth: 6, Moisture: 0.28}, {Depth: 18, Moisture: 0.3}, {Depth: 54, Moisture: 0.1}}
+
+	tests := []struct {
+		name   string
+		days   []Day
+		today  int
+		soil   []weather.SoilLevel
+		want   string
+		amount float64
+	}{
+		// 13.5 mm deficit, 3 mm of rain in the next two days; rain after that does not count
+		{"dry", dry, 2, drySoil, AdviceWater, 10.5},
+		{"no soil data", dry, 2, nil, AdviceWater, 10.5},
+		{"moist root zone", dry, 2, moistSoil, AdviceMoist, 0},
+		{"rain on the way", dry, 3, drySoil, AdviceRain, 0},
+		{"small deficit", dry, 0, drySoil, AdviceMoist, 0},
+	}
+	for _, tt := range tests {
+		got := Recommend(tt.days, tt.today, tt.soil)
+		if got.Kind != tt.want || math.Abs(got.Amount-tt.amount) > 1e-9 {
+			t.Errorf("%s: got %+v, want %s %.1f", tt.name, got, tt.want, tt.amount)
+		}
+	}
+}
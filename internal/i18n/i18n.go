@@ -44,6 +44,72 @@ func Label(key string) string {
 		return active.LabelWind
 	case "feels":
 		return active.LabelFeels
+	case "time":
+		return active.LabelTime
+	case "temp":
+		return active.LabelTemp
+	case "rain":
+		return active.LabelRain
+	case "precip":
+		return active.LabelPrecip
+	case "sun":
+		return active.LabelSun
+	case "uv":
+		return active.LabelUV
+	case "air":
+		return active.LabelAir
+	case "pollen":
+		return active.LabelPollen
+	case "waves":
+		return active.LabelWaves
+	case "swell":
+		return active.LabelSwell
+	case "sea":
+		return active.LabelSea
+	case "period":
+		return active.LabelPeriod
+	case "dir":
+		return active.LabelDir
+	case "observed":
+		return active.LabelObserved
+	case "model":
+		return active.LabelModel
+	case "spread":
+		return active.LabelSpread
+	case "pressure":
+		return active.LabelPressure
+	case "dewpoint":
+		return active.LabelDewPoint
+	case "clouds":
+		return active.LabelClouds
+	case "visibility":
+		return active.LabelVisibility
+	case "gusts":
+		return active.LabelGusts
+	case "freezinglevel":
+		return active.LabelFreezingLevel
+	case "newsnow":
+		return active.LabelNewSnow
+	case "snowdepth":
+		return active.LabelSnowDepth
+	case "energy":
+		return active.LabelEnergy
+	case "total":
+		return active.LabelTotal
+	case "soil":
+		return active.LabelSoil
+	case "moisture":
+		return active.LabelMoisture
+	case "balance":
+		return active.LabelBalance
+	case "discharge":
+		return active.LabelDischarge
+	case "median":
+		return active.LabelMedian
+	case "max":
+		return active.LabelMax
+	case "floodrisk":
+		return active.LabelFloodRisk
 	default:
 		return key
 	}
@@ -61,6 +127,30 @@ func Condition(code int) string {
 	return ""
 }
 
+// Warning returns the translated name for a warning kind
+// ("heat", "frost", "gust", "precip", "thunderstorm"), or the kind itself if unknown.
+func Warning(kind string) string {
+	if active == nil {
+		return kind
+	}
+	if name, ok := active.Warnings[kind]; ok {
+		return name
+	}
+	return kind
+}
+
+// FloodRisk returns the translated name for a flood risk level
+// ("normal", "elevated", "high", "severe"), or the level itself if unknown.
+func FloodRisk(level string) string {
+	if active == nil {
+		return level
+	}
+	if name, ok := active.FloodRisks[level]; ok {
+		return name
+	}
+	return level
+}
+
 // DayAbbr returns the translated day abbreviation for a time.Weekday.
 func DayAbbr(wd time.Weekday) string {
 	if active == nil {
@@ -77,6 +167,33 @@ func Cardinal(idx int) string {
 	return active.Cardinals[idx]
 }
 
+// UVCategory returns the translated WHO UV index category for level 0-4
+// (low, moderate, high, very high, extreme).
+func UVCategory(level int) string {
+	if active == nil || level < 0 || level > 4 {
+		return "?"
+	}
+	return active.UVCategories[level]
+}
+
+// AQICategory returns the translated European AQI category for level 0-5
+// (good, fair, moderate, poor, very poor, extremely poor).
+func AQICategory(level int) string {
+	if active == nil || level < 0 || level > 5 {
+		return "?"
+	}
+	return active.AQICategories[level]
+}
+
+// PollenName returns the translated plant name for pollen type index 0-5
+// (alder, birch, grass, mugwort, olive, ragweed).
+func PollenName(idx int) string {
+	if active == nil || idx < 0 || idx > 5 {
+		return "?"
+	}
+	return active.PollenNames[idx]
+}
+
 // FormatDay formats a date string (YYYY-MM-DD) as a localized "DayAbbr DD" string.
 func FormatDay(dateStr string) string {
 	t, err := time.Parse("2006-01-02", dateStr)
@@ -86,6 +203,32 @@ func FormatDay(dateStr string) string {
 	return fmt.Sprintf("%s %02d", DayAbbr(t.Weekday()), t.Day())
 }
 
+// FormatDate formats a date string (YYYY-MM-DD) as a localized day and
+// abbreviated month, e.g. "Feb 16" or "16. Feb.".
+func FormatDate(dateStr string) string {
+	t, err := time.Parse("2006-01-02", dateStr)
+	if err != nil {
+		return dateStr
+	}
+	if active == nil {
+		return t.Format("Jan 2")
+	}
+	return fmt.Sprintf(active.DateFormat, t.Day(), active.MonthAbbreviations[t.Month()-1])
+}
+
+// FormatTime formats a local timestamp (YYYY-MM-DDTHH:MM) as a clock time
+// in the 12h or 24h convention of the active language.
+func FormatTime(ts string) string {
+	t, err := time.Parse("2006-01-02T15:04", ts)
+	if err != nil {
+		return ts
+	}
+	if active == nil {
+		return t.Format("15:04")
+	}
+	return t.Format(active.TimeFormat)
+}
+
 // TipManualLocation returns the localized tip message for manual location.
 func TipManualLocation() string {
 	if active == nil {
@@ -93,3 +236,79 @@ func TipManualLocation() string {
 	}
 	return active.TipManualLocation
 }
+
+// PollenUnavailable returns the localized message shown when no pollen data
+// exists for the location.
+func PollenUnavailable() string {
+	if active == nil {
+		return "No pollen data for this region (Europe only)"
+	}
+	return active.PollenUnavailable
+}
+
+// Anomaly returns the localized "compared to normal" phrase for a temperature
+// difference. amount is the already formatted absolute difference (e.g. "4°");
+// differences below one degree count as near normal.
+func Anomaly(delta float64, amount string) string {
+	if active == nil {
+		return amount
+	}
+	switch {
+	case delta >= 1:
+		return fmt.Sprintf(active.AnomalyAbove, amount)
+	case delta <= -1:
+		return fmt.Sprintf(active.AnomalyBelow, amount)
+	default:
+		return active.AnomalyNear
+	}
+}
+
+// Nowcast returns the localized one-line precipitation outlook for the next two
+// hours. startsIn is the number of minutes until rain starts (0 if it is
+// raining now, negative if no rain is expected); stopsAt is the local time
+// (YYYY-MM-DDTHH:MM) it stops, or empty if it continues beyond two hours.
+func Nowcast(startsIn int, stopsAt string) string {
+	if active == nil {
+		return ""
+	}
+	switch {
+	case startsIn < 0:
+		return active.NowcastDry
+	case startsIn == 0 && stopsAt == "":
+		return active.NowcastContinuing
+	case startsIn == 0:
+		return fmt.Sprintf(active.NowcastStop, FormatTime(stopsAt))
+	case stopsAt == "":
+		return fmt.Sprintf(active.NowcastStart, startsIn)
+	default:
+		return fmt.Sprintf(active.NowcastStartStop, startsIn, FormatTime(stopsAt))
+	}
+}
+
+// GardenAdvice returns the localized watering recommendation for an advice
+// kind ("water", "rain" or "moist"). amount is the already formatted amount of
+// water, used for "water".
+func GardenAdvice(kind, amount string) string {
+	if active == nil {
+		return kind
+	}
+	switch kind {
+	case "water":
+		return fmt.Sprintf(active.GardenWater, amount)
+	case "rain":
+		return active.GardenRain
+	case "moist":
+		return active.GardenMoist
+	default:
+		return kind
+	}
+}
+
+// Stale returns the localized banner for cached data shown instead of a
+// fresh forecast. asOf is the already formatted time the data was fetched.
+func Stale(asOf string) string {
+	if active == nil {
+		return "Stale, as of " + asOf
+	}
+	return fmt.Sprintf(active.Stale, asOf)
+}
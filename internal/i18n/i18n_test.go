@@ -2,6 +2,7 @@ package i18n
 
 import (
 	"os"
+	"strings"
 	"testing"
 	"time"
 )
@@ -50,6 +51,39 @@ func TestLabel(t *testing.T) {
 		{"humidity", "Humidity:"},
 		{"wind", "Wind:"},
 		{"feels", "feels"},
+		{"time", "Time"},
+		{"temp", "Temp"},
+		{"rain", "Rain"},
+		{"precip", "Precip."},
+		{"sun", "Sun:"},
+		{"uv", "UV:"},
+		{"air", "Air quality:"},
+		{"pollen", "Pollen"},
+		{"waves", "Waves"},
+		{"swell", "Swell"},
+		{"sea", "Sea"},
+		{"period", "Period"},
+		{"dir", "Dir."},
+		{"observed", "Observed"},
+		{"model", "Model"},
+		{"spread", "Spread"},
+		{"pressure", "Pressure"},
+		{"dewpoint", "Dew point"},
+		{"clouds", "Clouds"},
+		{"visibility", "Visibility"},
+		{"gusts", "Gusts"},
+		{"freezinglevel", "Freezing level"},
+		{"newsnow", "New snow"},
+		{"snowdepth", "Snow depth"},
+		{"energy", "Energy"},
+		{"total", "Total"},
+		{"soil", "Soil"},
+		{"moisture", "Moisture"},
+		{"balance", "Balance"},
+		{"discharge", "Discharge"},
+		{"median", "Median"},
+		{"max", "Max"},
+		{"floodrisk", "Flood risk"},
 	}
 	for _, tt := range tests {
 		got := Label(tt.key)
@@ -124,6 +158,19 @@ func TestCardinalGerman(t *testing.T) {
 	}
 }
 
+func TestUVCategory(t *testing.T) {
+	Init("en")
+	if got := UVCategory(0); got != "Low" {
+		t.Errorf("UVCategory(0) = %q, want %q", got, "Low")
+	}
+	if got := UVCategory(4); got != "Extreme" {
+		t.Errorf("UVCategory(4) = %q, want %q", got, "Extreme")
+	}
+	if got := UVCategory(5); got != "?" {
+		t.Errorf("UVCategory(5) = %q, want %q", got, "?")
+	}
+}
+
 func TestFormatDay(t *testing.T) {
 	Init("en")
 	// 2026-02-14 is a Saturday
@@ -141,6 +188,57 @@ func TestFormatDayGerman(t *testing.T) {
 	}
 }
 
+func TestFormatDate(t *testing.T) {
+	tests := []struct {
+		lang string
+		want string
+	}{
+		{"en", "Feb 16"},
+		{"de", "16. Feb."},
+		{"fr", "16 févr."},
+		{"zh", "2月16日"},
+	}
+	for _, tt := range tests {
+		Init(tt.lang)
+		if got := FormatDate("2026-02-16"); got != tt.want {
+			t.Errorf("[%s] FormatDate(\"2026-02-16\") = %q, want %q", tt.lang, got, tt.want)
+		}
+	}
+}
+
+func TestAllLanguagesHaveMonths(t *testing.T) {
+	for langCode, lang := range registry {
+		if lang.DateFormat == "" {
+			t.Errorf("language %q has no date format", langCode)
+		}
+		for i, month := range lang.MonthAbbreviations {
+			if month == "" {
+				t.Errorf("language %q missing month abbreviation %d", langCode, i+1)
+			}
+		}
+	}
+}
+
+func TestFormatTime(t *testing.T) {
+	Init("en")
+	if got := FormatTime("2026-02-14T07:28"); got != "7:28 AM" {
+		t.Errorf("FormatTime(\"2026-02-14T07:28\") = %q, want %q", got, "7:28 AM")
+	}
+	if got := FormatTime("2026-02-14T17:21"); got != "5:21 PM" {
+		t.Errorf("FormatTime(\"2026-02-14T17:21\") = %q, want %q", got, "5:21 PM")
+	}
+}
+
+func TestFormatTimeGerman(t *testing.T) {
+	Init("de")
+	if got := FormatTime("2026-02-14T07:28"); got != "07:28" {
+		t.Errorf("FormatTime(\"2026-02-14T07:28\") = %q, want %q", got, "07:28")
+	}
+	if got := FormatTime("invalid"); got != "invalid" {
+		t.Errorf("FormatTime(\"invalid\") = %q, want %q", got, "invalid")
+	}
+}
+
 func TestTipManualLocation(t *testing.T) {
 	Init("en")
 	got := TipManualLocation()
@@ -200,3 +298,175 @@ func TestAllLanguagesHaveConditions(t *testing.T) {
 		}
 	}
 }
+
+func TestAllLanguagesHaveUVCategories(t *testing.T) {
+	for langCode, lang := range registry {
+		for i, cat := range lang.UVCategories {
+			if cat == "" {
+				t.Errorf("language %q missing UV category %d", langCode, i)
+			}
+		}
+	}
+}
+
+func TestAllLanguagesHaveAQICategories(t *testing.T) {
+	for langCode, lang := range registry {
+		for i, cat := range lang.AQICategories {
+			if cat == "" {
+				t.Errorf("language %q missing AQI category %d", langCode, i)
+			}
+		}
+	}
+}
+
+func TestAllLanguagesHavePollenNames(t *testing.T) {
+	for langCode, lang := range registry {
+		for i, name := range lang.PollenNames {
+			if name == "" {
+				t.Errorf("language %q missing pollen name %d", langCode, i)
+			}
+		}
+		if lang.PollenUnavailable == "" {
+			t.Errorf("language %q missing pollen unavailable message", langCode)
+		}
+	}
+}
+
+func TestAnomaly(t *testing.T) {
+	Init("en")
+	tests := []struct {
+		delta float64
+		want  string
+	}{
+		{4.2, "4° above normal"},
+		{-3, "4° below normal"},
+		{0.4, "Near normal"},
+	}
+	for _, tt := range tests {
+		if got := Anomaly(tt.delta, "4°"); got != tt.want {
+			t.Errorf("Anomaly(%v) = %q, want %q", tt.delta, got, tt.want)
+		}
+	}
+}
+
+func TestAllLanguagesHaveAnomalyPhrases(t *testing.T) {
+	for langCode, lang := range registry {
+		for _, f := range []string{lang.AnomalyAbove, lang.AnomalyBelow} {
+			if !strings.Contains(f, "%s") {
+				t.Errorf("language %q anomaly format %q missing %%s", langCode, f)
+			}
+		}
+		if lang.AnomalyNear == "" {
+			t.Errorf("language %q missing near-normal phrase", langCode)
+		}
+	}
+}
+
+func TestAllLanguagesHaveWarnings(t *testing.T) {
+	for langCode, lang := range registry {
+		for _, kind := range []string{"heat", "frost", "gust", "precip", "thunderstorm"} {
+			if lang.Warnings[kind] == "" {
+				t.Errorf("language %q missing warning name for %q", langCode, kind)
+			}
+		}
+	}
+}
+
+func TestAllLanguagesHaveFloodRisks(t *testing.T) {
+	for langCode, lang := range registry {
+		for _, level := range []string{"normal", "elevated", "high", "severe"} {
+			if lang.FloodRisks[level] == "" {
+				t.Errorf("language %q missing flood risk name for %q", langCode, level)
+			}
+		}
+	}
+}
+
+func TestNowcast(t *testing.T) {
+	Init("en")
+	tests := []struct {
+		startsIn int
+		stopsAt  string
+		want     string
+	}{
+		{-1, "", "No rain expected in the next 2 hours"},
+		{0, "", "Rain for at least the next 2 hours"},
+		{0, "2026-02-14T14:45", "Rain stopping around 2:45 PM"},
+		{15, "", "Rain starting in ~15 min"},
+		{15, "2026-02-14T15:00", "Rain starting in ~15 min, stopping around 3:00 PM"},
+	}
+	for _, tt := range tests {
+		if got := Nowcast(tt.startsIn, tt.stopsAt); got != tt.want {
+			t.Errorf("Nowcast(%d, %q) = %q, want %q", tt.startsIn, tt.stopsAt, got, tt.want)
+		}
+	}
+
+	Init("de")
+	if got := Nowcast(30, "2026-02-14T15:00"); got != "Regen in ca. 30 Min., endet gegen 15:00" {
+		t.Errorf("Nowcast in German = %q", got)
+	}
+}
+
+func TestAllLanguagesHaveNowcastPhrases(t *testing.T) {
+	for langCode, lang := range registry {
+		checks := []struct {
+			format string
+			verbs  []string
+		}{
+			{lang.NowcastStart, []string{"%d"}},
+			{lang.NowcastStartStop, []string{"%[1]d", "%[2]s"}},
+			{lang.NowcastStop, []string{"%s"}},
+		}
+		for _, c := range checks {
+			for _, verb := range c.verbs {
+				if !strings.Contains(c.format, verb) {
+					t.Errorf("language %q nowcast format %q missing %s", langCode, c.format, verb)
+				}
+			}
+		}
+		if lang.NowcastContinuing == "" || lang.NowcastDry == "" {
+			t.Errorf("language %q missing nowcast phrases", langCode)
+		}
+	}
+}
+
+func TestGardenAdvice(t *testing.T) {
+	Init("en")
+	tests := []struct {
+		kind string
+		want string
+	}{
+		{"water", "Water today: about 12 mm"},
+		{"rain", "No need to water: rain is on the way"},
+		{"moist", "No need to water: the soil is moist enough"},
+		{"unknown", "unknown"},
+	}
+	for _, tt := range tests {
+		if got := GardenAdvice(tt.kind, "12 mm"); got != tt.want {
+			t.Errorf("GardenAdvice(%q) = %q, want %q", tt.kind, got, tt.want)
+		}
+	}
+}
+
+func TestAllLanguagesHaveGardenAdvice(t *testing.T) {
+	for langCode, lang := range registry {
+		if !strings.Contains(lang.GardenWater, "%s") {
+			t.Errorf("language %q garden format %q missing %%s", langCode, lang.GardenWater)
+		}
+		if lang.GardenRain == "" || lang.GardenMoist == "" {
+			t.Errorf("language %q missing garden advice", langCode)
+		}
+	}
+}
+
+func TestStale(t *testing.T) {
+	Init("en")
+	if got := Stale("8:30 AM"); got != "Stale, as of 8:30 AM" {
+		t.Errorf("Stale = %q", got)
+	}
+	for langCode, lang := range registry {
+		if !strings.Contains(lang.Stale, "%s") {
+			t.Errorf("language %q stale format %q missing %%s", langCode, lang.Stale)
+		}
+	}
+}
@@ -2,18 +2,72 @@ package i18n
 
 // Lang holds all translatable strings for a language.
 type Lang struct {
-	Code              string
-	LabelDay          string
-	LabelHi           string
-	LabelLo           string
-	LabelCond         string
-	LabelHumidity     string
-	LabelWind         string
-	LabelFeels        string
-	DayAbbreviations  [7]string  // indexed by time.Weekday (Sun=0..Sat=6)
-	Cardinals         [16]string // N, NNE, NE, ENE, E, ESE, SE, SSE, S, SSW, SW, WSW, W, WNW, NW, NNW
-	Conditions        map[int]string // WMO code -> description
-	TipManualLocation string
+	Code               string
+	LabelDay           string
+	LabelHi            string
+	LabelLo            string
+	LabelCond          string
+	LabelHumidity      string
+	LabelWind          string
+	LabelFeels         string
+	LabelTime          string
+	LabelTemp          string
+	LabelRain          string
+	LabelPrecip        string
+	LabelSun           string
+	LabelUV            string
+	LabelAir           string
+	LabelPollen        string
+	LabelWaves         string
+	LabelSwell         string
+	LabelSea           string
+	LabelPeriod        string
+	LabelDir           string
+	LabelObserved      string
+	LabelModel         string
+	LabelSpread        string
+	LabelPressure      string
+	LabelDewPoint      string
+	LabelClouds        string
+	LabelVisibility    string
+	LabelGusts         string
+	LabelFreezingLevel string
+	LabelNewSnow       string
+	LabelSnowDepth     string
+	LabelEnergy        string
+	LabelTotal         string
+	LabelSoil          string
+	LabelMoisture      string
+	LabelBalance       string
+	LabelDischarge     string
+	LabelMedian        string
+	LabelMax           string
+	LabelFloodRisk     string
+	TimeFormat         string            // Go time layout for clock times, e.g. "15:04" or "3:04 PM"
+	DayAbbreviations   [7]string         // indexed by time.Weekday (Sun=0..Sat=6)
+	MonthAbbreviations [12]string        // January..December
+	DateFormat         string            // fmt format of day number and month abbreviation, e.g. "%[2]s %[1]d"
+	Cardinals          [16]string        // N, NNE, NE, ENE, E, ESE, SE, SSE, S, SSW, SW, WSW, W, WNW, NW, NNW
+	UVCategories       [5]string         // WHO UV index categories: low, moderate, high, very high, extreme
+	AQICategories      [6]string         // European AQI bands: good, fair, moderate, poor, very poor, extremely poor
+	PollenNames        [6]string         // alder, birch, grass, mugwort, olive, ragweed
+	Conditions         map[int]string    // WMO code -> description
+	Warnings           map[string]string // warning kind -> name
+	FloodRisks         map[string]string // flood risk level -> name
+	TipManualLocation  string
+	PollenUnavailable  string
+	AnomalyAbove       string // format with the amount, e.g. "%s above normal"
+	AnomalyBelow       string
+	AnomalyNear        string
+	NowcastStart       string // format with the minutes until rain starts
+	NowcastStartStop   string // format with the minutes until rain starts (%[1]d) and the time it stops (%[2]s)
+	NowcastStop        string // format with the time rain stops
+	NowcastContinuing  string
+	NowcastDry         string
+	GardenWater        string // format with the amount of water, e.g. "Water today: about %s"
+	GardenRain         string
+	GardenMoist        string
+	Stale              string // format with the time cached data was fetched, e.g. "Stale, as of %s"
 }
 
 var registry = map[string]*Lang{}
@@ -2,21 +2,69 @@ package i18n
 
 func init() {
 	register(&Lang{
-		Code:          "de",
-		LabelDay:      "Tag",
-		LabelHi:       "Max",
-		LabelLo:       "Min",
-		LabelCond:     "Wetter",
-		LabelHumidity: "Feuchte:",
-		LabelWind:     "Wind:",
-		LabelFeels:    "gefühlt",
+		Code:               "de",
+		LabelDay:           "Tag",
+		LabelHi:            "Max",
+		LabelLo:            "Min",
+		LabelCond:          "Wetter",
+		LabelHumidity:      "Feuchte:",
+		LabelWind:          "Wind:",
+		LabelFeels:         "gefühlt",
+		LabelTime:          "Zeit",
+		LabelTemp:          "Temp",
+		LabelRain:          "Regen",
+		LabelPrecip:        "Nieders.",
+		LabelSun:           "Sonne:",
+		LabelUV:            "UV:",
+		LabelAir:           "Luftqualität:",
+		LabelPollen:        "Pollen",
+		LabelWaves:         "Wellen",
+		LabelSwell:         "Dünung",
+		LabelSea:           "Meer",
+		LabelPeriod:        "Periode",
+		LabelDir:           "Richt.",
+		LabelObserved:      "Beobachtet",
+		LabelModel:         "Modell",
+		LabelSpread:        "Streuung",
+		LabelPressure:      "Luftdruck",
+		LabelDewPoint:      "Taupunkt",
+		LabelClouds:        "Wolken",
+		LabelVisibility:    "Sicht",
+		LabelGusts:         "Böen",
+		LabelFreezingLevel: "Nullgradgrenze",
+		LabelNewSnow:       "Neuschnee",
+		LabelSnowDepth:     "Schneehöhe",
+		LabelEnergy:        "Ertrag",
+		LabelTotal:         "Summe",
+		LabelSoil:          "Boden",
+		LabelMoisture:      "Feuchte",
+		LabelBalance:       "Bilanz",
+		LabelDischarge:     "Abfluss",
+		LabelMedian:        "Median",
+		LabelMax:           "Max",
+		LabelFloodRisk:     "Hochwasserrisiko",
+		TimeFormat:         "15:04",
 		DayAbbreviations: [7]string{
 			"So", "Mo", "Di", "Mi", "Do", "Fr", "Sa",
 		},
+		MonthAbbreviations: [12]string{
+			"Jan.", "Feb.", "März", "Apr.", "Mai", "Juni",
+			"Juli", "Aug.", "Sep.", "Okt.", "Nov.", "Dez.",
+		},
+		DateFormat: "%[1]d. %[2]s",
 		Cardinals: [16]string{
 			"N", "NNO", "NO", "ONO", "O", "OSO", "SO", "SSO",
 			"S", "SSW", "SW", "WSW", "W", "WNW", "NW", "NNW",
 		},
+		UVCategories: [5]string{
+			"Niedrig", "Mäßig", "Hoch", "Sehr hoch", "Extrem",
+		},
+		AQICategories: [6]string{
+			"Gut", "Ausreichend", "Mäßig", "Schlecht", "Sehr schlecht", "Extrem schlecht",
+		},
+		PollenNames: [6]string{
+			"Erle", "Birke", "Gräser", "Beifuß", "Olive", "Ambrosia",
+		},
 		Conditions: map[int]string{
 			0: "Klarer Himmel", 1: "Überwiegend klar", 2: "Teilweise bewölkt", 3: "Bedeckt",
 			45: "Nebel", 48: "Reifnebel",
@@ -29,6 +77,26 @@ func init() {
 			85: "Leichte Schneeschauer", 86: "Starke Schneeschauer",
 			95: "Gewitter", 96: "Gewitter mit leichtem Hagel", 99: "Gewitter mit starkem Hagel",
 		},
+		Warnings: map[string]string{
+			"heat": "Hitze", "frost": "Strenger Frost", "gust": "Sturmböen",
+			"precip": "Starkniederschlag", "thunderstorm": "Gewitter",
+		},
+		FloodRisks: map[string]string{
+			"normal": "Normal", "elevated": "Erhöht", "high": "Hoch", "severe": "Sehr hoch",
+		},
 		TipManualLocation: "Tipp: Verwenden Sie --city oder --lat/--lon, um einen Ort manuell anzugeben",
+		PollenUnavailable: "Keine Pollendaten für diese Region (nur Europa)",
+		AnomalyAbove:      "%s über dem Mittel",
+		AnomalyBelow:      "%s unter dem Mittel",
+		AnomalyNear:       "Im Mittel",
+		NowcastStart:      "Regen in ca. %d Min.",
+		NowcastStartStop:  "Regen in ca. %[1]d Min., endet gegen %[2]s",
+		NowcastStop:       "Regen endet gegen %s",
+		NowcastContinuing: "Regen für mindestens 2 Stunden",
+		NowcastDry:        "Kein Regen in den nächsten 2 Stunden",
+		GardenWater:       "Heute gießen: etwa %s",
+		GardenRain:        "Nicht gießen: Regen ist unterwegs",
+		GardenMoist:       "Nicht gießen: der Boden ist feucht genug",
+		Stale:             "Veraltet, Stand %s",
 	})
 }
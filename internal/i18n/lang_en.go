@@ -2,21 +2,69 @@ package i18n
 
 func init() {
 	register(&Lang{
-		Code:          "en",
-		LabelDay:      "Day",
-		LabelHi:       "Hi",
-		LabelLo:       "Lo",
-		LabelCond:     "Cond.",
-		LabelHumidity: "Humidity:",
-		LabelWind:     "Wind:",
-		LabelFeels:    "feels",
+		Code:               "en",
+		LabelDay:           "Day",
+		LabelHi:            "Hi",
+		LabelLo:            "Lo",
+		LabelCond:          "Cond.",
+		LabelHumidity:      "Humidity:",
+		LabelWind:          "Wind:",
+		LabelFeels:         "feels",
+		LabelTime:          "Time",
+		LabelTemp:          "Temp",
+		LabelRain:          "Rain",
+		LabelPrecip:        "Precip.",
+		LabelSun:           "Sun:",
+		LabelUV:            "UV:",
+		LabelAir:           "Air quality:",
+		LabelPollen:        "Pollen",
+		LabelWaves:         "Waves",
+		LabelSwell:         "Swell",
+		LabelSea:           "Sea",
+		LabelPeriod:        "Period",
+		LabelDir:           "Dir.",
+		LabelObserved:      "Observed",
+		LabelModel:         "Model",
+		LabelSpread:        "Spread",
+		LabelPressure:      "Pressure",
+		LabelDewPoint:      "Dew point",
+		LabelClouds:        "Clouds",
+		LabelVisibility:    "Visibility",
+		LabelGusts:         "Gusts",
+		LabelFreezingLevel: "Freezing level",
+		LabelNewSnow:       "New snow",
+		LabelSnowDepth:     "Snow depth",
+		LabelEnergy:        "Energy",
+		LabelTotal:         "Total",
+		LabelSoil:          "Soil",
+		LabelMoisture:      "Moisture",
+		LabelBalance:       "Balance",
+		LabelDischarge:     "Discharge",
+		LabelMedian:        "Median",
+		LabelMax:           "Max",
+		LabelFloodRisk:     "Flood risk",
+		TimeFormat:         "3:04 PM",
 		DayAbbreviations: [7]string{
 			"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat",
 		},
+		MonthAbbreviations: [12]string{
+			"Jan", "Feb", "Mar", "Apr", "May", "Jun",
+			"Jul", "Aug", "Sep", "Oct", "Nov", "Dec",
+		},
+		DateFormat: "%[2]s %[1]d",
 		Cardinals: [16]string{
 			"N", "NNE", "NE", "ENE", "E", "ESE", "SE", "SSE",
 			"S", "SSW", "SW", "WSW", "W", "WNW", "NW", "NNW",
 		},
+		UVCategories: [5]string{
+			"Low", "Moderate", "High", "Very high", "Extreme",
+		},
+		AQICategories: [6]string{
+			"Good", "Fair", "Moderate", "Poor", "Very poor", "Extremely poor",
+		},
+		PollenNames: [6]string{
+			"Alder", "Birch", "Grass", "Mugwort", "Olive", "Ragweed",
+		},
 		Conditions: map[int]string{
 			0: "Clear sky", 1: "Mainly clear", 2: "Partly cloudy", 3: "Overcast",
 			45: "Fog", 48: "Depositing rime fog",
@@ -29,6 +77,26 @@ func init() {
 			85: "Slight snow showers", 86: "Heavy snow showers",
 			95: "Thunderstorm", 96: "Thunderstorm with slight hail", 99: "Thunderstorm with heavy hail",
 		},
+		Warnings: map[string]string{
+			"heat": "Heat", "frost": "Severe frost", "gust": "Storm gusts",
+			"precip": "Heavy precipitation", "thunderstorm": "Thunderstorm",
+		},
+		FloodRisks: map[string]string{
+			"normal": "Normal", "elevated": "Elevated", "high": "High", "severe": "Severe",
+		},
 		TipManualLocation: "Tip: Use --city or --lat/--lon to specify a location manually",
+		PollenUnavailable: "No pollen data for this region (Europe only)",
+		AnomalyAbove:      "%s above normal",
+		AnomalyBelow:      "%s below normal",
+		AnomalyNear:       "Near normal",
+		NowcastStart:      "Rain starting in ~%d min",
+		NowcastStartStop:  "Rain starting in ~%[1]d min, stopping around %[2]s",
+		NowcastStop:       "Rain stopping around %s",
+		NowcastContinuing: "Rain for at least the next 2 hours",
+		NowcastDry:        "No rain expected in the next 2 hours",
+		GardenWater:       "Water today: about %s",
+		GardenRain:        "No need to water: rain is on the way",
+		GardenMoist:       "No need to water: the soil is moist enough",
+		Stale:             "Stale, as of %s",
 	})
 }
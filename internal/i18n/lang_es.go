@@ -2,21 +2,69 @@ package i18n
 
 func init() {
 	register(&Lang{
-		Code:          "es",
-		LabelDay:      "Día",
-		LabelHi:       "Máx",
-		LabelLo:       "Mín",
-		LabelCond:     "Cond.",
-		LabelHumidity: "Humedad:",
-		LabelWind:     "Viento:",
-		LabelFeels:    "sens.",
+		Code:               "es",
+		LabelDay:           "Día",
+		LabelHi:            "Máx",
+		LabelLo:            "Mín",
+		LabelCond:          "Cond.",
+		LabelHumidity:      "Humedad:",
+		LabelWind:          "Viento:",
+		LabelFeels:         "sens.",
+		LabelTime:          "Hora",
+		LabelTemp:          "Temp",
+		LabelRain:          "Lluvia",
+		LabelPrecip:        "Precip.",
+		LabelSun:           "Sol:",
+		LabelUV:            "UV:",
+		LabelAir:           "Calidad del aire:",
+		LabelPollen:        "Polen",
+		LabelWaves:         "Olas",
+		LabelSwell:         "Mar fondo",
+		LabelSea:           "Mar",
+		LabelPeriod:        "Periodo",
+		LabelDir:           "Dir.",
+		LabelObserved:      "Observado",
+		LabelModel:         "Modelo",
+		LabelSpread:        "Dispersión",
+		LabelPressure:      "Presión",
+		LabelDewPoint:      "Punto de rocío",
+		LabelClouds:        "Nubes",
+		LabelVisibility:    "Visibilidad",
+		LabelGusts:         "Ráfagas",
+		LabelFreezingLevel: "Nivel de congelación",
+		LabelNewSnow:       "Nieve nueva",
+		LabelSnowDepth:     "Espesor de nieve",
+		LabelEnergy:        "Energía",
+		LabelTotal:         "Total",
+		LabelSoil:          "Suelo",
+		LabelMoisture:      "Humedad",
+		LabelBalance:       "Balance",
+		LabelDischarge:     "Caudal",
+		LabelMedian:        "Mediana",
+		LabelMax:           "Máx.",
+		LabelFloodRisk:     "Riesgo de crecida",
+		TimeFormat:         "15:04",
 		DayAbbreviations: [7]string{
 			"Dom", "Lun", "Mar", "Mié", "Jue", "Vie", "Sáb",
 		},
+		MonthAbbreviations: [12]string{
+			"ene", "feb", "mar", "abr", "may", "jun",
+			"jul", "ago", "sept", "oct", "nov", "dic",
+		},
+		DateFormat: "%[1]d %[2]s",
 		Cardinals: [16]string{
 			"N", "NNE", "NE", "ENE", "E", "ESE", "SE", "SSE",
 			"S", "SSO", "SO", "OSO", "O", "ONO", "NO", "NNO",
 		},
+		UVCategories: [5]string{
+			"Bajo", "Moderado", "Alto", "Muy alto", "Extremo",
+		},
+		AQICategories: [6]string{
+			"Buena", "Razonable", "Moderada", "Mala", "Muy mala", "Extremadamente mala",
+		},
+		PollenNames: [6]string{
+			"Aliso", "Abedul", "Gramíneas", "Artemisa", "Olivo", "Ambrosía",
+		},
 		Conditions: map[int]string{
 			0: "Cielo despejado", 1: "Mayormente despejado", 2: "Parcialmente nublado", 3: "Nublado",
 			45: "Niebla", 48: "Niebla con escarcha",
@@ -29,6 +77,26 @@ func init() {
 			85: "Chubascos de nieve ligeros", 86: "Chubascos de nieve intensos",
 			95: "Tormenta", 96: "Tormenta con granizo ligero", 99: "Tormenta con granizo intenso",
 		},
+		Warnings: map[string]string{
+			"heat": "Calor", "frost": "Helada fuerte", "gust": "Rachas fuertes",
+			"precip": "Precipitación intensa", "thunderstorm": "Tormenta",
+		},
+		FloodRisks: map[string]string{
+			"normal": "Normal", "elevated": "Elevado", "high": "Alto", "severe": "Muy alto",
+		},
 		TipManualLocation: "Consejo: Use --city o --lat/--lon para especificar una ubicación manualmente",
+		PollenUnavailable: "Sin datos de polen para esta región (solo Europa)",
+		AnomalyAbove:      "%s sobre lo normal",
+		AnomalyBelow:      "%s bajo lo normal",
+		AnomalyNear:       "Dentro de lo normal",
+		NowcastStart:      "Lluvia en ~%d min",
+		NowcastStartStop:  "Lluvia en ~%[1]d min, hasta las %[2]s aprox.",
+		NowcastStop:       "La lluvia para hacia las %s",
+		NowcastContinuing: "Lluvia durante al menos 2 horas",
+		NowcastDry:        "Sin lluvia en las próximas 2 horas",
+		GardenWater:       "Regar hoy: unos %s",
+		GardenRain:        "No hace falta regar: viene lluvia",
+		GardenMoist:       "No hace falta regar: el suelo está bastante húmedo",
+		Stale:             "Datos antiguos, de las %s",
 	})
 }
@@ -2,21 +2,69 @@ package i18n
 
 func init() {
 	register(&Lang{
-		Code:          "fr",
-		LabelDay:      "Jour",
-		LabelHi:       "Max",
-		LabelLo:       "Min",
-		LabelCond:     "Cond.",
-		LabelHumidity: "Humidité:",
-		LabelWind:     "Vent:",
-		LabelFeels:    "ress.",
+		Code:               "fr",
+		LabelDay:           "Jour",
+		LabelHi:            "Max",
+		LabelLo:            "Min",
+		LabelCond:          "Cond.",
+		LabelHumidity:      "Humidité:",
+		LabelWind:          "Vent:",
+		LabelFeels:         "ress.",
+		LabelTime:          "Heure",
+		LabelTemp:          "Temp",
+		LabelRain:          "Pluie",
+		LabelPrecip:        "Précip.",
+		LabelSun:           "Soleil:",
+		LabelUV:            "UV:",
+		LabelAir:           "Qualité de l'air:",
+		LabelPollen:        "Pollen",
+		LabelWaves:         "Vagues",
+		LabelSwell:         "Houle",
+		LabelSea:           "Mer",
+		LabelPeriod:        "Période",
+		LabelDir:           "Dir.",
+		LabelObserved:      "Observé",
+		LabelModel:         "Modèle",
+		LabelSpread:        "Écart",
+		LabelPressure:      "Pression",
+		LabelDewPoint:      "Point de rosée",
+		LabelClouds:        "Nuages",
+		LabelVisibility:    "Visibilité",
+		LabelGusts:         "Rafales",
+		LabelFreezingLevel: "Isotherme 0°C",
+		LabelNewSnow:       "Neige fraîche",
+		LabelSnowDepth:     "Hauteur de neige",
+		LabelEnergy:        "Énergie",
+		LabelTotal:         "Total",
+		LabelSoil:          "Sol",
+		LabelMoisture:      "Humidité",
+		LabelBalance:       "Bilan",
+		LabelDischarge:     "Débit",
+		LabelMedian:        "Médiane",
+		LabelMax:           "Max",
+		LabelFloodRisk:     "Risque de crue",
+		TimeFormat:         "15:04",
 		DayAbbreviations: [7]string{
 			"Dim", "Lun", "Mar", "Mer", "Jeu", "Ven", "Sam",
 		},
+		MonthAbbreviations: [12]string{
+			"janv.", "févr.", "mars", "avr.", "mai", "juin",
+			"juil.", "août", "sept.", "oct.", "nov.", "déc.",
+		},
+		DateFormat: "%[1]d %[2]s",
 		Cardinals: [16]string{
 			"N", "NNE", "NE", "ENE", "E", "ESE", "SE", "SSE",
 			"S", "SSO", "SO", "OSO", "O", "ONO", "NO", "NNO",
 		},
+		UVCategories: [5]string{
+			"Faible", "Modéré", "Élevé", "Très élevé", "Extrême",
+		},
+		AQICategories: [6]string{
+			"Bonne", "Moyenne", "Dégradée", "Mauvaise", "Très mauvaise", "Extrêmement mauvaise",
+		},
+		PollenNames: [6]string{
+			"Aulne", "Bouleau", "Graminées", "Armoise", "Olivier", "Ambroisie",
+		},
 		Conditions: map[int]string{
 			0: "Ciel dégagé", 1: "Principalement dégagé", 2: "Partiellement nuageux", 3: "Couvert",
 			45: "Brouillard", 48: "Brouillard givrant",
@@ -29,6 +77,26 @@ func init() {
 			85: "Averses de neige légères", 86: "Averses de neige fortes",
 			95: "Orage", 96: "Orage avec grêle légère", 99: "Orage avec forte grêle",
 		},
+		Warnings: map[string]string{
+			"heat": "Chaleur", "frost": "Gel sévère", "gust": "Rafales de tempête",
+			"precip": "Fortes précipitations", "thunderstorm": "Orage",
+		},
+		FloodRisks: map[string]string{
+			"normal": "Normal", "elevated": "Élevé", "high": "Fort", "severe": "Très fort",
+		},
 		TipManualLocation: "Conseil: Utilisez --city ou --lat/--lon pour spécifier un lieu manuellement",
+		PollenUnavailable: "Pas de données polliniques ici (Europe uniquement)",
+		AnomalyAbove:      "%s au-dessus de la normale",
+		AnomalyBelow:      "%s sous la normale",
+		AnomalyNear:       "Proche de la normale",
+		NowcastStart:      "Pluie dans ~%d min",
+		NowcastStartStop:  "Pluie dans ~%[1]d min, jusqu'à %[2]s environ",
+		NowcastStop:       "Fin de la pluie vers %s",
+		NowcastContinuing: "Pluie pendant au moins 2 heures",
+		NowcastDry:        "Pas de pluie prévue dans les 2 prochaines heures",
+		GardenWater:       "Arroser aujourd'hui: environ %s",
+		GardenRain:        "Pas besoin d'arroser: la pluie arrive",
+		GardenMoist:       "Pas besoin d'arroser: le sol est assez humide",
+		Stale:             "Données périmées, état à %s",
 	})
 }
@@ -2,21 +2,69 @@ package i18n
 
 func init() {
 	register(&Lang{
-		Code:          "it",
-		LabelDay:      "Giorno",
-		LabelHi:       "Max",
-		LabelLo:       "Min",
-		LabelCond:     "Cond.",
-		LabelHumidity: "Umidità:",
-		LabelWind:     "Vento:",
-		LabelFeels:    "perc.",
+		Code:               "it",
+		LabelDay:           "Giorno",
+		LabelHi:            "Max",
+		LabelLo:            "Min",
+		LabelCond:          "Cond.",
+		LabelHumidity:      "Umidità:",
+		LabelWind:          "Vento:",
+		LabelFeels:         "perc.",
+		LabelTime:          "Ora",
+		LabelTemp:          "Temp",
+		LabelRain:          "Pioggia",
+		LabelPrecip:        "Precip.",
+		LabelSun:           "Sole:",
+		LabelUV:            "UV:",
+		LabelAir:           "Qualità dell'aria:",
+		LabelPollen:        "Polline",
+		LabelWaves:         "Onde",
+		LabelSwell:         "Mare lungo",
+		LabelSea:           "Mare",
+		LabelPeriod:        "Periodo",
+		LabelDir:           "Dir.",
+		LabelObserved:      "Osservato",
+		LabelModel:         "Modello",
+		LabelSpread:        "Dispersione",
+		LabelPressure:      "Pressione",
+		LabelDewPoint:      "Punto di rugiada",
+		LabelClouds:        "Nuvole",
+		LabelVisibility:    "Visibilità",
+		LabelGusts:         "Raffiche",
+		LabelFreezingLevel: "Zero termico",
+		LabelNewSnow:       "Neve fresca",
+		LabelSnowDepth:     "Altezza neve",
+		LabelEnergy:        "Energia",
+		LabelTotal:         "Totale",
+		LabelSoil:          "Suolo",
+		LabelMoisture:      "Umidità",
+		LabelBalance:       "Bilancio",
+		LabelDischarge:     "Portata",
+		LabelMedian:        "Mediana",
+		LabelMax:           "Max",
+		LabelFloodRisk:     "Rischio di piena",
+		TimeFormat:         "15:04",
 		DayAbbreviations: [7]string{
 			"Dom", "Lun", "Mar", "Mer", "Gio", "Ven", "Sab",
 		},
+		MonthAbbreviations: [12]string{
+			"gen", "feb", "mar", "apr", "mag", "giu",
+			"lug", "ago", "set", "ott", "nov", "dic",
+		},
+		DateFormat: "%[1]d %[2]s",
 		Cardinals: [16]string{
 			"N", "NNE", "NE", "ENE", "E", "ESE", "SE", "SSE",
 			"S", "SSO", "SO", "OSO", "O", "ONO", "NO", "NNO",
 		},
+		UVCategories: [5]string{
+			"Basso", "Moderato", "Alto", "Molto alto", "Estremo",
+		},
+		AQICategories: [6]string{
+			"Buona", "Discreta", "Moderata", "Scadente", "Molto scadente", "Estremamente scadente",
+		},
+		PollenNames: [6]string{
+			"Ontano", "Betulla", "Graminacee", "Artemisia", "Olivo", "Ambrosia",
+		},
 		Conditions: map[int]string{
 			0: "Cielo sereno", 1: "Prevalentemente sereno", 2: "Parzialmente nuvoloso", 3: "Coperto",
 			45: "Nebbia", 48: "Nebbia con brina",
@@ -29,6 +77,26 @@ func init() {
 			85: "Rovesci di neve leggeri", 86: "Rovesci di neve forti",
 			95: "Temporale", 96: "Temporale con grandine leggera", 99: "Temporale con grandine forte",
 		},
+		Warnings: map[string]string{
+			"heat": "Caldo", "frost": "Gelo intenso", "gust": "Raffiche di tempesta",
+			"precip": "Precipitazioni intense", "thunderstorm": "Temporale",
+		},
+		FloodRisks: map[string]string{
+			"normal": "Normale", "elevated": "Elevato", "high": "Alto", "severe": "Molto alto",
+		},
 		TipManualLocation: "Suggerimento: Usa --city o --lat/--lon per specificare una posizione manualmente",
+		PollenUnavailable: "Nessun dato sui pollini qui (solo Europa)",
+		AnomalyAbove:      "%s sopra la norma",
+		AnomalyBelow:      "%s sotto la norma",
+		AnomalyNear:       "Nella norma",
+		NowcastStart:      "Pioggia tra ~%d min",
+		NowcastStartStop:  "Pioggia tra ~%[1]d min, fino alle %[2]s circa",
+		NowcastStop:       "La pioggia smette verso le %s",
+		NowcastContinuing: "Pioggia per almeno 2 ore",
+		NowcastDry:        "Nessuna pioggia prevista nelle prossime 2 ore",
+		GardenWater:       "Annaffiare oggi: circa %s",
+		GardenRain:        "Non serve annaffiare: sta arrivando la pioggia",
+		GardenMoist:       "Non serve annaffiare: il terreno è abbastanza umido",
+		Stale:             "Dati non aggiornati, delle %s",
 	})
 }
@@ -2,21 +2,69 @@ package i18n
 
 func init() {
 	register(&Lang{
-		Code:          "zh",
-		LabelDay:      "日期",
-		LabelHi:       "最高",
-		LabelLo:       "最低",
-		LabelCond:     "天气",
-		LabelHumidity: "湿度:",
-		LabelWind:     "风速:",
-		LabelFeels:    "体感",
+		Code:               "zh",
+		LabelDay:           "日期",
+		LabelHi:            "最高",
+		LabelLo:            "最低",
+		LabelCond:          "天气",
+		LabelHumidity:      "湿度:",
+		LabelWind:          "风速:",
+		LabelFeels:         "体感",
+		LabelTime:          "时间",
+		LabelTemp:          "温度",
+		LabelRain:          "降水",
+		LabelPrecip:        "降水量",
+		LabelSun:           "日照:",
+		LabelUV:            "紫外线:",
+		LabelAir:           "空气质量:",
+		LabelPollen:        "花粉",
+		LabelWaves:         "浪高",
+		LabelSwell:         "涌浪",
+		LabelSea:           "海温",
+		LabelPeriod:        "周期",
+		LabelDir:           "方向",
+		LabelObserved:      "实测",
+		LabelModel:         "模型",
+		LabelSpread:        "分歧",
+		LabelPressure:      "气压",
+		LabelDewPoint:      "露点",
+		LabelClouds:        "云量",
+		LabelVisibility:    "能见度",
+		LabelGusts:         "阵风",
+		LabelFreezingLevel: "零度层高度",
+		LabelNewSnow:       "新雪",
+		LabelSnowDepth:     "积雪深度",
+		LabelEnergy:        "发电量",
+		LabelTotal:         "合计",
+		LabelSoil:          "土壤",
+		LabelMoisture:      "湿度",
+		LabelBalance:       "水分平衡",
+		LabelDischarge:     "流量",
+		LabelMedian:        "中位数",
+		LabelMax:           "最大",
+		LabelFloodRisk:     "洪水风险",
+		TimeFormat:         "15:04",
 		DayAbbreviations: [7]string{
 			"周日", "周一", "周二", "周三", "周四", "周五", "周六",
 		},
+		MonthAbbreviations: [12]string{
+			"1月", "2月", "3月", "4月", "5月", "6月",
+			"7月", "8月", "9月", "10月", "11月", "12月",
+		},
+		DateFormat: "%[2]s%[1]d日",
 		Cardinals: [16]string{
 			"北", "北北东", "东北", "东北东", "东", "东南东", "东南", "南南东",
 			"南", "南南西", "西南", "西南西", "西", "西北西", "西北", "北北西",
 		},
+		UVCategories: [5]string{
+			"低", "中等", "高", "很高", "极高",
+		},
+		AQICategories: [6]string{
+			"优", "良", "中等", "差", "很差", "极差",
+		},
+		PollenNames: [6]string{
+			"桤木", "桦树", "禾草", "艾蒿", "橄榄", "豚草",
+		},
 		Conditions: map[int]string{
 			0: "晴", 1: "大部晴朗", 2: "局部多云", 3: "阴天",
 			45: "雾", 48: "雾凇",
@@ -29,6 +77,26 @@ func init() {
 			85: "小阵雪", 86: "大阵雪",
 			95: "雷暴", 96: "雷暴伴小冰雹", 99: "雷暴伴大冰雹",
 		},
+		Warnings: map[string]string{
+			"heat": "高温", "frost": "严寒", "gust": "大风",
+			"precip": "强降水", "thunderstorm": "雷暴",
+		},
+		FloodRisks: map[string]string{
+			"normal": "正常", "elevated": "偏高", "high": "高", "severe": "极高",
+		},
 		TipManualLocation: "提示: 使用 --city 或 --lat/--lon 手动指定位置",
+		PollenUnavailable: "该地区无花粉数据（仅限欧洲）",
+		AnomalyAbove:      "比常年偏高%s",
+		AnomalyBelow:      "比常年偏低%s",
+		AnomalyNear:       "接近常年",
+		NowcastStart:      "约%d分钟后开始下雨",
+		NowcastStartStop:  "约%[1]d分钟后开始下雨，%[2]s左右停止",
+		NowcastStop:       "雨将在%s左右停止",
+		NowcastContinuing: "未来2小时持续有雨",
+		NowcastDry:        "未来2小时无雨",
+		GardenWater:       "今天需要浇水：约%s",
+		GardenRain:        "无需浇水：即将下雨",
+		GardenMoist:       "无需浇水：土壤足够湿润",
+		Stale:             "数据已过时，截至%s",
 	})
 }
@@ -4,21 +4,50 @@ package location
 #cgo CFLAGS: -x objective-c -mmacosx-version-min=10.14
 #cgo LDFLAGS: -framework CoreLocation -framework Foundation -mmacosx-version-min=10.14
 
+#include <stdlib.h>
 #include "corelocation_darwin.h"
 */
 import "C"
-import "fmt"
+import (
+	"context"
+	"fmt"
+	"time"
+	"unsafe"
+)
 
-const coreLocationTimeout = 10.0 // seconds
+const coreLocationTimeout = 10 * time.Second
 
 // GetCoreLocation attempts to get the current location via macOS CoreLocation.
-func GetCoreLocation() (Location, error) {
+// It waits for a fix until coreLocationTimeout passes or ctx is done.
+func GetCoreLocation(ctx context.Context) (Location, error) {
 	if C.location_services_enabled() == 0 {
 		return Location{}, fmt.Errorf("location services disabled")
 	}
 
+	timeout := coreLocationTimeout
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < timeout {
+		timeout = time.Until(deadline)
+	}
+
+	// The wait runs a run loop on its own thread; the flag ends it early
+	cancelled := (*C.int)(C.calloc(1, C.sizeof_int))
+	defer C.free(unsafe.Pointer(cancelled))
+
 	var result C.CLResult
-	ret := C.get_current_location(&result, C.double(coreLocationTimeout))
+	var ret C.int
+	done := make(chan struct{})
+	go func() {
+		ret = C.get_current_location(&result, C.double(timeout.Seconds()), cancelled)
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+		C.cancel_current_location(cancelled)
+		<-done
+		return Location{}, ctx.Err()
+	}
+
 	if ret != 0 {
 		return Location{}, fmt.Errorf("CoreLocation failed with code %d", int(ret))
 	}
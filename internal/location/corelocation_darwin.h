@@ -9,7 +9,11 @@ typedef struct {
 // get_current_location attempts to get the current location via CoreLocation.
 // Returns 0 on success, non-zero on failure.
 // timeout_seconds specifies how long to wait for a location fix.
-int get_current_location(CLResult *result, double timeout_seconds);
+// The wait ends early, returning -3, once *cancelled is set.
+int get_current_location(CLResult *result, double timeout_seconds, int *cancelled);
+
+// cancel_current_location ends a wait in get_current_location from another thread.
+void cancel_current_location(int *cancelled);
 
 // location_services_enabled returns 1 if location services are enabled, 0 otherwise.
 int location_services_enabled(void);
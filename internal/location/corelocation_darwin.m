@@ -26,7 +26,10 @@
 
 @end
 
-int get_current_location(CLResult *result, double timeout_seconds) {
+// cancelSliceSeconds is how often the wait checks for cancellation.
+static const double cancelSliceSeconds = 0.1;
+
+int get_current_location(CLResult *result, double timeout_seconds, int *cancelled) {
     @autoreleasepool {
         if (![CLLocationManager locationServicesEnabled]) {
             return -1;
@@ -39,10 +42,21 @@ int get_current_location(CLResult *result, double timeout_seconds) {
 
         [manager startUpdatingLocation];
 
-        CFRunLoopRunInMode(kCFRunLoopDefaultMode, timeout_seconds, false);
+        CFAbsoluteTime deadline = CFAbsoluteTimeGetCurrent() + timeout_seconds;
+        while (!delegate.done && !__atomic_load_n(cancelled, __ATOMIC_SEQ_CST)) {
+            CFTimeInterval left = deadline - CFAbsoluteTimeGetCurrent();
+            if (left <= 0) {
+                break;
+            }
+            CFRunLoopRunInMode(kCFRunLoopDefaultMode, MIN(left, cancelSliceSeconds), false);
+        }
 
         [manager stopUpdatingLocation];
 
+        if (__atomic_load_n(cancelled, __ATOMIC_SEQ_CST)) {
+            return -3; // cancelled
+        }
+
         if (delegate.lastLocation != nil) {
             result->latitude = delegate.lastLocation.coordinate.latitude;
             result->longitude = delegate.lastLocation.coordinate.longitude;
@@ -57,6 +71,10 @@ int get_current_location(CLResult *result, double timeout_seconds) {
     }
 }
 
+void cancel_current_location(int *cancelled) {
+    __atomic_store_n(cancelled, 1, __ATOMIC_SEQ_CST);
+}
+
 int location_services_enabled(void) {
     return [CLLocationManager locationServicesEnabled] ? 1 : 0;
 }
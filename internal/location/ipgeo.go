@@ -1,11 +1,17 @@
 package location
 
-import "net/http"
-import "time"
+import (
+	"context"
+	"net/http"
+)
 
 const ipGeoURL = "http://ip-api.com/json/"
 
-// GetIPLocation fetches location from IP geolocation service.
-func GetIPLocation() (Location, error) {
-	return fetchIPLocation(ipGeoURL, &http.Client{Timeout: 5 * time.Second})
+// GetIPLocation fetches location from IP geolocation service, sending the
+// request through client, or http.DefaultClient if client is nil.
+func GetIPLocation(ctx context.Context, client *http.Client) (Location, error) {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return fetchIPLocation(ctx, ipGeoURL, client)
 }
@@ -0,0 +1,24 @@
+package location
+
+import "goweather/internal/cache"
+
+// lastKnownFile is the cache entry keeping the last detected location, so
+// that detection also works offline.
+func lastKnownFile() string {
+	return cache.Path("location.json")
+}
+
+// loadLastKnown returns the last detected location, marked as cached.
+func loadLastKnown() (Location, error) {
+	var loc Location
+	if err := cache.ReadJSON(lastKnownFile(), &loc); err != nil {
+		return Location{}, err
+	}
+	loc.Source = "cached"
+	return loc, nil
+}
+
+// saveLastKnown stores a detected location.
+func saveLastKnown(loc Location) error {
+	return cache.WriteJSON(lastKnownFile(), loc)
+}
@@ -1,6 +1,7 @@
 package location
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -12,26 +13,49 @@ type Location struct {
 	Longitude float64
 	City      string
 	Country   string
-	Source    string // "corelocation", "ip", "manual"
+	Source    string // "corelocation", "ip", "manual", "cached"
 }
 
 // Config holds runtime configuration from CLI flags.
 type Config struct {
-	City      string
-	Latitude  float64
-	Longitude float64
-	Imperial  bool
-	NoColor   bool
-	Days      int
+	City       string
+	Latitude   float64
+	Longitude  float64
+	Imperial   bool
+	NoColor    bool
+	Days       int
+	PastDays   int
+	Hourly     bool
+	Hours      int
+	Details    bool
+	Elevation  *float64 // forecast elevation in meters, nil for the terrain elevation
+	Air        bool
+	Pollen     bool
+	Marine     bool
+	Snow       bool
+	DegreeDays bool
+	Flood      bool
+	Models     []string // Open-Meteo model identifiers to compare
+	Ensemble   bool
+	Nowcast    bool
+	Anomaly    bool
+	Provider   string // weather backend name, see weather.ProviderNames
+	Offline    bool   // use cached data only, never the network
+	From       string // historical range start (YYYY-MM-DD), empty for a forecast
+	To         string // historical range end (YYYY-MM-DD)
+
+	HTTPClient *http.Client // client for IP geolocation, nil for http.DefaultClient
 }
 
 // GeocodeFunc is a function type for city-to-location geocoding.
 // Set by the weather package to avoid circular imports.
-var GeocodeFunc func(city string) (float64, float64, string, string, error)
+var GeocodeFunc func(ctx context.Context, city string) (float64, float64, string, string, error)
 
 // ResolveLocation determines the user's location based on config.
-// Priority: lat/lon flags > city flag > CoreLocation > IP geolocation.
-func ResolveLocation(cfg Config) (Location, error) {
+// Priority: lat/lon flags > city flag > CoreLocation > IP geolocation >
+// the last detected location. Offline, detection goes straight to the last
+// detected location. Detection stops early when ctx is done.
+func ResolveLocation(ctx context.Context, cfg Config) (Location, error) {
 	if cfg.Latitude != 0 || cfg.Longitude != 0 {
 		return Location{
 			Latitude:  cfg.Latitude,
@@ -41,7 +65,7 @@ func ResolveLocation(cfg Config) (Location, error) {
 	}
 
 	if cfg.City != "" && GeocodeFunc != nil {
-		lat, lon, city, country, err := GeocodeFunc(cfg.City)
+		lat, lon, city, country, err := GeocodeFunc(ctx, cfg.City)
 		if err != nil {
 			return Location{}, err
 		}
@@ -54,17 +78,40 @@ func ResolveLocation(cfg Config) (Location, error) {
 		}, nil
 	}
 
-	// Try CoreLocation first, fall back to IP silently
-	loc, err := GetCoreLocation()
-	if err == nil {
+	if cfg.Offline {
+		loc, err := loadLastKnown()
+		if err != nil {
+			return Location{}, fmt.Errorf("no cached location to use offline")
+		}
 		return loc, nil
 	}
 
-	return GetIPLocation()
+	// Try CoreLocation first, fall back to IP silently
+	loc, err := GetCoreLocation(ctx)
+	if err != nil && ctx.Err() == nil {
+		loc, err = GetIPLocation(ctx, cfg.HTTPClient)
+	}
+	if err != nil {
+		if ctx.Err() != nil {
+			return Location{}, fmt.Errorf("location detection stopped: %w", ctx.Err())
+		}
+		// Without a network, the last detected location is the best guess
+		if cached, cacheErr := loadLastKnown(); cacheErr == nil {
+			return cached, nil
+		}
+		return Location{}, err
+	}
+
+	_ = saveLastKnown(loc)
+	return loc, nil
 }
 
-func fetchIPLocation(url string, client *http.Client) (Location, error) {
-	resp, err := client.Get(url)
+func fetchIPLocation(ctx context.Context, url string, client *http.Client) (Location, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return Location{}, fmt.Errorf("IP geolocation request failed: %w", err)
+	}
+	resp, err := client.Do(req)
 	if err != nil {
 		return Location{}, fmt.Errorf("IP geolocation request failed: %w", err)
 	}
@@ -75,12 +122,12 @@ func fetchIPLocation(url string, client *http.Client) (Location, error) {
 	}
 
 	var result struct {
-		Lat         float64 `json:"lat"`
-		Lon         float64 `json:"lon"`
-		City        string  `json:"city"`
-		RegionName  string  `json:"regionName"`
-		Country     string  `json:"country"`
-		Status      string  `json:"status"`
+		Lat        float64 `json:"lat"`
+		Lon        float64 `json:"lon"`
+		City       string  `json:"city"`
+		RegionName string  `json:"regionName"`
+		Country    string  `json:"country"`
+		Status     string  `json:"status"`
 	}
 
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
@@ -1,9 +1,13 @@
 package location
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"goweather/internal/cache"
 	"net/http"
 	"net/http/httptest"
+	"path/filepath"
 	"testing"
 )
 
@@ -56,7 +60,7 @@ func TestFetchIPLocation(t *testing.T) {
 			}))
 			defer server.Close()
 
-			loc, err := fetchIPLocation(server.URL, server.Client())
+			loc, err := fetchIPLocation(context.Background(), server.URL, server.Client())
 			if tt.wantErr {
 				if err == nil {
 					t.Error("expected error, got nil")
@@ -81,7 +85,7 @@ func TestFetchIPLocation(t *testing.T) {
 
 func TestResolveLocationManual(t *testing.T) {
 	cfg := Config{Latitude: 48.85, Longitude: 2.35}
-	loc, err := ResolveLocation(cfg)
+	loc, err := ResolveLocation(context.Background(), cfg)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -94,13 +98,13 @@ func TestResolveLocationManual(t *testing.T) {
 }
 
 func TestResolveLocationCity(t *testing.T) {
-	GeocodeFunc = func(city string) (float64, float64, string, string, error) {
+	GeocodeFunc = func(ctx context.Context, city string) (float64, float64, string, string, error) {
 		return 52.52, 13.41, "Berlin", "Germany", nil
 	}
 	defer func() { GeocodeFunc = nil }()
 
 	cfg := Config{City: "Berlin"}
-	loc, err := ResolveLocation(cfg)
+	loc, err := ResolveLocation(context.Background(), cfg)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -111,14 +115,14 @@ func TestResolveLocationCity(t *testing.T) {
 
 func TestResolveLocationLatLonOverCity(t *testing.T) {
 	geocodeCalled := false
-	GeocodeFunc = func(city string) (float64, float64, string, string, error) {
+	GeocodeFunc = func(ctx context.Context, city string) (float64, float64, string, string, error) {
 		geocodeCalled = true
 		return 0, 0, "", "", nil
 	}
 	defer func() { GeocodeFunc = nil }()
 
 	cfg := Config{City: "Berlin", Latitude: 48.85, Longitude: 2.35}
-	loc, err := ResolveLocation(cfg)
+	loc, err := ResolveLocation(context.Background(), cfg)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -129,3 +133,48 @@ func TestResolveLocationLatLonOverCity(t *testing.T) {
 		t.Errorf("source = %q, want %q", loc.Source, "manual")
 	}
 }
+
+func TestResolveLocationOffline(t *testing.T) {
+	old := cache.Dir
+	cache.Dir = filepath.Join(t.TempDir(), "weather")
+	defer func() { cache.Dir = old }()
+
+	if _, err := ResolveLocation(context.Background(), Config{Offline: true}); err == nil {
+		t.Error("expected error without a cached location, got nil")
+	}
+
+	if err := saveLastKnown(Location{Latitude: 52.52, Longitude: 13.41, City: "Berlin", Country: "Germany", Source: "ip"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	loc, err := ResolveLocation(context.Background(), Config{Offline: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if loc.City != "Berlin" || loc.Latitude != 52.52 || loc.Source != "cached" {
+		t.Errorf("location = %+v, want cached Berlin", loc)
+	}
+
+	// Explicit coordinates need no cache
+	loc, err = ResolveLocation(context.Background(), Config{Latitude: 48.85, Longitude: 2.35, Offline: true})
+	if err != nil || loc.Source != "manual" {
+		t.Errorf("location = %+v (%v), want the manual coordinates", loc, err)
+	}
+}
+
+func TestResolveLocationCanceled(t *testing.T) {
+	old := cache.Dir
+	cache.Dir = filepath.Join(t.TempDir(), "weather")
+	defer func() { cache.Dir = old }()
+
+	// An interrupted detection must not fall back to the cached location
+	if err := saveLastKnown(Location{Latitude: 52.52, Longitude: 13.41, Source: "ip"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := ResolveLocation(ctx, Config{})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("error = %v, want context.Canceled", err)
+	}
+}
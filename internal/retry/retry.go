@@ -0,0 +1,154 @@
+// Package retry repeats HTTP requests that failed for transient reasons,
+// such as a dropped connection or an overloaded server, backing off
+// exponentially with jitter between attempts.
+package retry
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Defaults used by NewTransport.
+const (
+	defaultAttempts  = 3
+	defaultBaseDelay = 500 * time.Millisecond
+)
+
+// maxDelay caps the backoff between attempts, and maxRetryAfter the wait a
+// server may ask for; a longer Retry-After gives up instead.
+const (
+	maxDelay      = 5 * time.Second
+	maxRetryAfter = 30 * time.Second
+)
+
+// Transport is an http.RoundTripper that retries GET and HEAD requests after
+// network errors and 429, 502, 503 and 504 responses. The delay doubles with
+// every attempt, randomized to spread out clients that failed together,
+// unless the server gives a Retry-After. Waiting stops as soon as the
+// request's context is done, and a retry that could not finish before the
+// context's deadline is not attempted.
+type Transport struct {
+	Base      http.RoundTripper
+	Attempts  int           // total attempts, including the first
+	BaseDelay time.Duration // delay before the first retry
+}
+
+// NewTransport returns a retrying transport around base, or around
+// http.DefaultTransport if base is nil.
+func NewTransport(base http.RoundTripper) *Transport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &Transport{Base: base, Attempts: defaultAttempts, BaseDelay: defaultBaseDelay}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if (req.Method != http.MethodGet && req.Method != http.MethodHead) || req.Body != nil {
+		return t.Base.RoundTrip(req)
+	}
+
+	ctx := req.Context()
+	for attempt := 1; ; attempt++ {
+		resp, err := t.Base.RoundTrip(req)
+		if attempt >= t.Attempts || !retryable(ctx, resp, err) {
+			return resp, err
+		}
+
+		delay := backoff(t.BaseDelay, attempt)
+		if resp != nil {
+			if wait, ok := retryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+				if wait > maxRetryAfter {
+					return resp, nil
+				}
+				delay = wait
+			}
+		}
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+			return resp, err
+		}
+
+		if resp != nil {
+			// Drain the body so that the connection can be reused
+			io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+			resp.Body.Close()
+		}
+		if err := sleep(ctx, delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// retryable reports whether a failed attempt is worth repeating.
+func retryable(ctx context.Context, resp *http.Response, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	if err != nil {
+		// Unknown hosts stay unknown, and certificate errors need fixing;
+		// timeouts, refused and reset connections may pass
+		var dnsErr *net.DNSError
+		if errors.As(err, &dnsErr) && dnsErr.IsNotFound {
+			return false
+		}
+		var netErr net.Error
+		return errors.As(err, &netErr) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// backoff returns the delay before the retry following the given attempt:
+// base doubled per attempt and capped at maxDelay, with its upper half
+// randomized.
+func backoff(base time.Duration, attempt int) time.Duration {
+	d := base << (attempt - 1)
+	if d <= 0 || d > maxDelay {
+		d = maxDelay
+	}
+	half := d / 2
+	return half + rand.N(half+1)
+}
+
+// retryAfter parses a Retry-After header, given either in seconds or as an
+// HTTP date.
+func retryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if s, err := strconv.Atoi(value); err == nil {
+		if s < 0 {
+			return 0, false
+		}
+		return time.Duration(s) * time.Second, true
+	}
+	t, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+	if d := t.Sub(now); d > 0 {
+		return d, true
+	}
+	return 0, true
+}
+
+// sleep waits for d, or returns early with the context's error once ctx is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,220 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newClient returns a client that retries through a transport with a short
+// base delay.
+func newClient(server *httptest.Server) *http.Client {
+	t := NewTransport(server.Client().Transport)
+	t.BaseDelay = time.Millisecond
+	return &http.Client{Transport: t}
+}
+
+// failing serves the given status codes in turn, then 200 OK.
+func failing(hits *int32, header http.Header, codes ...int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		n := int(atomic.AddInt32(hits, 1))
+		if n <= len(codes) {
+			for k, v := range header {
+				w.Header()[k] = v
+			}
+			w.WriteHeader(codes[n-1])
+			return
+		}
+		w.Write([]byte("ok"))
+	}
+}
+
+func TestTransport(t *testing.T) {
+	tests := []struct {
+		name     string
+		codes    []int
+		wantCode int
+		wantHits int32
+	}{
+		{"success", nil, http.StatusOK, 1},
+		{"unavailable then success", []int{503, 502}, http.StatusOK, 3},
+		{"attempts exhausted", []int{504, 504, 504, 504}, http.StatusGatewayTimeout, 3},
+		{"client error", []int{404}, http.StatusNotFound, 1},
+		{"server error", []int{500}, http.StatusInternalServerError, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var hits int32
+			server := httptest.NewServer(failing(&hits, nil, tt.codes...))
+			defer server.Close()
+
+			resp, err := newClient(server).Get(server.URL)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			resp.Body.Close()
+			if resp.StatusCode != tt.wantCode {
+				t.Errorf("status = %d, want %d", resp.StatusCode, tt.wantCode)
+			}
+			if atomic.LoadInt32(&hits) != tt.wantHits {
+				t.Errorf("requests = %d, want %d", atomic.LoadInt32(&hits), tt.wantHits)
+			}
+		})
+	}
+}
+
+func TestTransportNetworkError(t *testing.T) {
+	var hits int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&hits, 1) == 1 {
+			// Drop the connection without a response
+			conn, _, _ := w.(http.Hijacker).Hijack()
+			conn.Close()
+			return
+		}
+		w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	resp, err := newClient(server).Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+	if atomic.LoadInt32(&hits) != 2 {
+		t.Errorf("requests = %d, want 2", atomic.LoadInt32(&hits))
+	}
+}
+
+func TestTransportRetryAfter(t *testing.T) {
+	var hits int32
+	header := http.Header{"Retry-After": {"1"}}
+	server := httptest.NewServer(failing(&hits, header, http.StatusTooManyRequests))
+	defer server.Close()
+
+	start := time.Now()
+	resp, err := newClient(server).Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || atomic.LoadInt32(&hits) != 2 {
+		t.Errorf("status = %d after %d requests, want 200 after 2", resp.StatusCode, atomic.LoadInt32(&hits))
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("retried after %v, want at least the 1s from Retry-After", elapsed)
+	}
+}
+
+func TestTransportRetryAfterTooLong(t *testing.T) {
+	var hits int32
+	header := http.Header{"Retry-After": {"3600"}}
+	server := httptest.NewServer(failing(&hits, header, http.StatusTooManyRequests))
+	defer server.Close()
+
+	resp, err := newClient(server).Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusTooManyRequests || atomic.LoadInt32(&hits) != 1 {
+		t.Errorf("status = %d after %d requests, want 429 after 1", resp.StatusCode, atomic.LoadInt32(&hits))
+	}
+}
+
+func TestTransportDeadline(t *testing.T) {
+	var hits int32
+	header := http.Header{"Retry-After": {"10"}}
+	server := httptest.NewServer(failing(&hits, header, http.StatusServiceUnavailable))
+	defer server.Close()
+
+	// A retry that cannot happen before the deadline returns the failure at once
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+
+	start := time.Now()
+	resp, err := newClient(server).Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusServiceUnavailable || atomic.LoadInt32(&hits) != 1 {
+		t.Errorf("status = %d after %d requests, want 503 after 1", resp.StatusCode, atomic.LoadInt32(&hits))
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("returned after %v, want no wait", elapsed)
+	}
+}
+
+func TestTransportCanceled(t *testing.T) {
+	var hits int32
+	server := httptest.NewServer(failing(&hits, nil, 503, 503))
+	defer server.Close()
+
+	client := newClient(server)
+	client.Transport.(*Transport).BaseDelay = time.Hour
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+
+	_, err := client.Do(req)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("error = %v, want context.Canceled", err)
+	}
+	if atomic.LoadInt32(&hits) != 1 {
+		t.Errorf("requests = %d, want 1", atomic.LoadInt32(&hits))
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	base := 100 * time.Millisecond
+	tests := []struct {
+		attempt int
+		want    time.Duration // upper bound; the delay is at least half of it
+	}{
+		{1, base},
+		{2, 2 * base},
+		{3, 4 * base},
+		{20, maxDelay},
+		{100, maxDelay},
+	}
+
+	for _, tt := range tests {
+		for range 20 {
+			if d := backoff(base, tt.attempt); d < tt.want/2 || d > tt.want {
+				t.Errorf("backoff(%v, %d) = %v, want between %v and %v", base, tt.attempt, d, tt.want/2, tt.want)
+			}
+		}
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		value  string
+		want   time.Duration
+		wantOK bool
+	}{
+		{"", 0, false},
+		{"120", 2 * time.Minute, true},
+		{"0", 0, true},
+		{"-5", 0, false},
+		{"Sun, 01 Mar 2026 12:00:30 GMT", 30 * time.Second, true},
+		{"Sun, 01 Mar 2026 11:00:00 GMT", 0, true},
+		{"soon", 0, false},
+	}
+
+	for _, tt := range tests {
+		got, ok := retryAfter(tt.value, now)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("retryAfter(%q) = %v, %v, want %v, %v", tt.value, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
@@ -0,0 +1,183 @@
+// Package solar estimates the energy yield of a photovoltaic system from a
+// radiation forecast, using a solar position and plane-of-array model.
+package solar
+
+import (
+	"fmt"
+	"goweather/internal/weather"
+	"math"
+	"time"
+)
+
+// albedo is the ground reflectance assumed for the reflected radiation on
+// tilted panels (typical for grass and soil).
+const albedo = 0.2
+
+// solarConstant caps the beam irradiance derived for a low sun (W/m²).
+const solarConstant = 1361
+
+// minElevation is the sun elevation (degrees) below which direct radiation is
+// ignored: dividing by sin(elevation) amplifies errors near the horizon.
+const minElevation = 2
+
+// Panel describes a PV system. Azimuth is the compass direction the panel
+// faces (180 = south) and Tilt its angle from horizontal, both in degrees.
+// Losses is the percentage lost to inverter, wiring, soiling and temperature.
+type Panel struct {
+	KWp     float64 `json:"kwp"`
+	Tilt    float64 `json:"tilt"`
+	Azimuth float64 `json:"azimuth"`
+	Losses  float64 `json:"losses"`
+}
+
+// Validate reports whether the panel settings are in range.
+func (p Panel) Validate() error {
+	switch {
+	case p.KWp <= 0:
+		return fmt.Errorf("kwp must be positive (got %g)", p.KWp)
+	case p.Tilt < 0 || p.Tilt > 90:
+		return fmt.Errorf("tilt must be between 0 and 90 degrees (got %g)", p.Tilt)
+	case p.Azimuth < 0 || p.Azimuth > 360:
+		return fmt.Errorf("azimuth must be between 0 and 360 degrees (got %g)", p.Azimuth)
+	case p.Losses < 0 || p.Losses >= 100:
+		return fmt.Errorf("losses must be between 0 and 100 percent (got %g)", p.Losses)
+	}
+	return nil
+}
+
+// HourlyYield is the average output of the system over one hour, which is
+// also the energy produced in that hour in kWh.
+type HourlyYield struct {
+	Time  string // local start of the hour, YYYY-MM-DDTHH:MM
+	Power float64
+}
+
+// DailyYield is the energy produced on one day in kWh.
+type DailyYield struct {
+	Date   string
+	Energy float64
+}
+
+// Position returns the sun's elevation above the horizon and its azimuth
+// (compass degrees, 0 = north) at time t, following the NOAA general solar
+// position equations. Accurate to a fraction of a degree, which is plenty for
+// yield estimates.
+func Position(t time.Time, lat, lon float64) (elevation, azimuth float64) {
+	t = t.UTC()
+	hour := float64(t.Hour()) + float64(t.Minute())/60 + float64(t.Second())/3600
+
+	// Fractional year in radians
+	daysInYear := 365.0
+	if y := t.Year(); y%4 == 0 && (y%100 != 0 || y%400 == 0) {
+		daysInYear = 366
+	}
+	g := 2 * math.Pi / daysInYear * (float64(t.YearDay()-1) + (hour-12)/24)
+
+	// Equation of time (minutes) and declination (radians)
+	eqTime := 229.18 * (0.000075 + 0.001868*math.Cos(g) - 0.032077*math.Sin(g) -
+		0.014615*math.Cos(2*g) - 0.040849*math.Sin(2*g))
+	decl := 0.006918 - 0.399912*math.Cos(g) + 0.070257*math.Sin(g) -
+		0.006758*math.Cos(2*g) + 0.000907*math.Sin(2*g) -
+		0.002697*math.Cos(3*g) + 0.00148*math.Sin(3*g)
+
+	// Hour angle from true solar time, negative in the morning
+	solarMinutes := hour*60 + eqTime + 4*lon
+	ha := radians(solarMinutes/4 - 180)
+
+	phi := radians(lat)
+	cosZenith := math.Sin(phi)*math.Sin(decl) + math.Cos(phi)*math.Cos(decl)*math.Cos(ha)
+	cosZenith = math.Max(-1, math.Min(1, cosZenith))
+	elevation = 90 - degrees(math.Acos(cosZenith))
+
+	// Azimuth measured from south, westward positive, then shifted to north
+	az := math.Atan2(math.Sin(ha), math.Cos(ha)*math.Sin(phi)-math.Tan(decl)*math.Cos(phi))
+	azimuth = math.Mod(degrees(az)+180, 360)
+	return elevation, azimuth
+}
+
+// PlaneOfArray returns the irradiance on a tilted panel (W/m²) from the global
+// horizontal, direct horizontal and diffuse horizontal radiation, using the
+// isotropic sky model: beam on the panel, the share of the sky dome the panel
+// sees, and ground-reflected light.
+func PlaneOfArray(global, direct, diffuse, sunElevation, sunAzimuth float64, p Panel) float64 {
+	tilt := radians(p.Tilt)
+
+	var beam float64
+	if sunElevation > minElevation && direct > 0 {
+		dni := math.Min(direct/math.Sin(radians(sunElevation)), solarConstant)
+		// Cosine of the angle of incidence between the sun and the panel normal
+		zenith := radians(90 - sunElevation)
+		cosAOI := math.Cos(zenith)*math.Cos(tilt) +
+			math.Sin(zenith)*math.Sin(tilt)*math.Cos(radians(sunAzimuth-p.Azimuth))
+		beam = dni * math.Max(cosAOI, 0)
+	}
+
+	sky := diffuse * (1 + math.Cos(tilt)) / 2
+	ground := global * albedo * (1 - math.Cos(tilt)) / 2
+	return beam + sky + ground
+}
+
+// Power returns the system output in kW for a plane-of-array irradiance,
+// scaling the rated power (at 1000 W/m²) and applying the losses.
+func Power(poa float64, p Panel) float64 {
+	return p.KWp * poa / 1000 * (1 - p.Losses/100)
+}
+
+// Estimate computes the hourly output and the daily energy of a panel at the
+// given location. Radiation values are averages over the preceding hour, so
+// the sun position is taken at the middle of each hour.
+func Estimate(r *weather.Radiation, lat, lon float64, p Panel) ([]HourlyYield, []DailyYield) {
+	zone := radiationZone(r)
+
+	var hourly []HourlyYield
+	var daily []DailyYield
+	var firstDate string
+	for _, h := range r.Hourly {
+		end, err := time.ParseInLocation("2006-01-02T15:04", h.Time, zone)
+		if err != nil {
+			continue
+		}
+		if firstDate == "" {
+			firstDate = end.Format("2006-01-02")
+		}
+		start := end.Add(-time.Hour)
+		date := start.Format("2006-01-02")
+		// The hour ending at midnight of the first day belongs to the day before
+		if date < firstDate {
+			continue
+		}
+
+		elevation, azimuth := Position(end.Add(-30*time.Minute), lat, lon)
+		power := Power(PlaneOfArray(h.Shortwave, h.Direct, h.Diffuse, elevation, azimuth, p), p)
+
+		hourly = append(hourly, HourlyYield{Time: start.Format("2006-01-02T15:04"), Power: power})
+
+		if len(daily) == 0 || daily[len(daily)-1].Date != date {
+			daily = append(daily, DailyYield{Date: date})
+		}
+		daily[len(daily)-1].Energy += power
+	}
+	return hourly, daily
+}
+
+// radiationZone returns the time zone of the radiation forecast, so that each
+// hour gets its own offset across a daylight saving change. Without the zone's
+// rules, the offset at the time of the forecast applies throughout.
+func radiationZone(r *weather.Radiation) *time.Location {
+	if r.Timezone != "" {
+		if zone, err := time.LoadLocation(r.Timezone); err == nil {
+			return zone
+		}
+	}
+	return time.FixedZone("", r.UTCOffset)
+}
+
+// radians converts an angle from degrees to radians.
+func radians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
+
+// degrees converts an angle from radians to degrees.
+func degrees(rad float64) float64 {
+	return rad * 180 / math.Pi
+}
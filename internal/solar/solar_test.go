@@ -0,0 +1,158 @@
+package solar
+
+import (
+	"goweather/internal/weather"
+	"math"
+	"testing"
+	"time"
+)
+
+// angleDiff returns the absolute difference between two compass directions.
+func angleDiff(a, b float64) float64 {
+	d := math.Mod(math.Abs(a-b), 360)
+	return math.Min(d, 360-d)
+}
+
+func TestPosition(t *testing.T) {
+	tests := []struct {
+		name          string
+		time          string
+		lat, lon      float64
+		wantElevation float64
+		wantAzimuth   float64
+		tolerance     float64
+	}{
+		// Summer solstice solar noon in Berlin: 90 - 52.52 + 23.44
+		{"Berlin solstice noon", "2026-06-21T11:08:00Z", 52.52, 13.405, 60.92, 180, 0.3},
+		// Berlin sunrise 07:28 CET; the sun's center is 0.83° below the horizon
+		{"Berlin sunrise", "2026-02-14T06:28:00Z", 52.52, 13.405, -0.83, 111, 0.5},
+		// Winter solstice noon in Sydney, with the sun to the north
+		{"Sydney winter noon", "2026-06-21T01:57:00Z", -33.87, 151.21, 32.69, 0, 0.3},
+	}
+	for _, tt := range tests {
+		ts, _ := time.Parse(time.RFC3339, tt.time)
+		elevation, azimuth := Position(ts, tt.lat, tt.lon)
+		if math.Abs(elevation-tt.wantElevation) > tt.tolerance {
+			t.Errorf("%s: elevation = %.2f, want %.2f", tt.name, elevation, tt.wantElevation)
+		}
+		if angleDiff(azimuth, tt.wantAzimuth) > 2 {
+			t.Errorf("%s: azimuth = %.1f, want %.0f", tt.name, azimuth, tt.wantAzimuth)
+		}
+	}
+
+	// The equinox sun passes almost overhead at the equator
+	ts, _ := time.Parse(time.RFC3339, "2026-03-20T12:07:00Z")
+	if elevation, _ := Position(ts, 0, 0); elevation < 89 {
+		t.Errorf("equator equinox elevation = %.2f, want above 89", elevation)
+	}
+}
+
+func TestPlaneOfArray(t *testing.T) {
+	// A horizontal panel receives the global radiation
+	flat := Panel{KWp: 1}
+	if got := PlaneOfArray(500, 350, 150, 30, 180, flat); math.Abs(got-500) > 1e-9 {
+		t.Errorf("horizontal POA = %f, want 500", got)
+	}
+
+	// A panel facing the sun gets the full beam: DNI = 350 / sin(30°) = 700
+	facing := Panel{KWp: 1, Tilt: 60, Azimuth: 180}
+	want := 700 + 150*(1+math.Cos(radians(60)))/2 + 500*albedo*(1-math.Cos(radians(60)))/2
+	if got := PlaneOfArray(500, 350, 150, 30, 180, facing); math.Abs(got-want) > 1e-9 {
+		t.Errorf("facing POA = %f, want %f", got, want)
+	}
+
+	// A vertical panel facing away from the sun only sees diffuse and reflected light
+	away := Panel{KWp: 1, Tilt: 90, Azimuth: 0}
+	want = 150*0.5 + 500*albedo*0.5
+	if got := PlaneOfArray(500, 350, 150, 30, 180, away); math.Abs(got-want) > 1e-9 {
+		t.Errorf("shaded POA = %f, want %f", got, want)
+	}
+
+	// Direct radiation near the horizon is ignored rather than amplified
+	if got := PlaneOfArray(20, 10, 10, 1, 120, facing); got > 20 {
+		t.Errorf("low sun POA = %f, want no beam contribution", got)
+	}
+}
+
+func TestPower(t *testing.T) {
+	p := Panel{KWp: 5, Losses: 14}
+	if got := Power(1000, p); math.Abs(got-4.3) > 1e-9 {
+		t.Errorf("Power(1000) = %f, want 4.3", got)
+	}
+	if got := Power(0, p); got != 0 {
+		t.Errorf("Power(0) = %f, want 0", got)
+	}
+}
+
+func TestEstimate(t *testing.T) {
+	r := &weather.Radiation{
+		UTCOffset: 3600,
+		Hourly: []weather.RadiationHour{
+			{Time: "2026-02-14T00:00"},
+			{Time: "2026-02-14T13:00", Shortwave: 419.5, Direct: 302, Diffuse: 117.5},
+			{Time: "2026-02-15T00:00"},
+			{Time: "2026-02-15T13:00", Shortwave: 146.8, Direct: 7.3, Diffuse: 139.5},
+		},
+	}
+
+	hourly, daily := Estimate(r, 52.52, 13.405, Panel{KWp: 1})
+	if len(hourly) != 3 {
+		t.Fatalf("hourly count = %d, want 3 (the hour before the first day is skipped)", len(hourly))
+	}
+	if hourly[0].Time != "2026-02-14T12:00" {
+		t.Errorf("hourly[0].time = %q, want the start of the hour", hourly[0].Time)
+	}
+	if math.Abs(hourly[0].Power-0.4195) > 1e-9 {
+		t.Errorf("horizontal 1 kWp output = %f, want 0.4195", hourly[0].Power)
+	}
+	if len(daily) != 2 || daily[0].Date != "2026-02-14" || daily[1].Date != "2026-02-15" {
+		t.Fatalf("daily = %+v, want Feb 14 and 15", daily)
+	}
+	if math.Abs(daily[0].Energy-0.4195) > 1e-9 {
+		t.Errorf("daily[0].energy = %f, want 0.4195", daily[0].Energy)
+	}
+
+	// A south-facing tilted panel beats a flat one under a low winter sun,
+	// but gains little on an overcast day
+	_, tilted := Estimate(r, 52.52, 13.405, Panel{KWp: 1, Tilt: 35, Azimuth: 180})
+	if tilted[0].Energy < daily[0].Energy*1.3 {
+		t.Errorf("tilted clear-sky energy = %f, want well above %f", tilted[0].Energy, daily[0].Energy)
+	}
+	if tilted[1].Energy > daily[1].Energy*1.1 {
+		t.Errorf("tilted overcast energy = %f, want close to %f", tilted[1].Energy, daily[1].Energy)
+	}
+}
+
+func TestEstimateDaylightSaving(t *testing.T) {
+	if _, err := time.LoadLocation("Europe/Berlin"); err != nil {
+		t.Skip("time zone database not available")
+	}
+
+	// Summer time starts on March 29; the forecast was made in winter time
+	hour := weather.RadiationHour{Time: "2026-03-30T13:00", Shortwave: 600, Direct: 450, Diffuse: 150}
+	zoned := &weather.Radiation{Timezone: "Europe/Berlin", UTCOffset: 3600, Hourly: []weather.RadiationHour{hour}}
+	summer := &weather.Radiation{UTCOffset: 7200, Hourly: []weather.RadiationHour{hour}}
+
+	p := Panel{KWp: 1, Tilt: 35, Azimuth: 180}
+	got, _ := Estimate(zoned, 52.52, 13.405, p)
+	want, _ := Estimate(summer, 52.52, 13.405, p)
+	if math.Abs(got[0].Power-want[0].Power) > 1e-9 {
+		t.Errorf("output after the change = %f, want %f as in summer time", got[0].Power, want[0].Power)
+	}
+}
+
+func TestPanelValidate(t *testing.T) {
+	if err := (Panel{KWp: 6.5, Tilt: 35, Azimuth: 180, Losses: 14}).Validate(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	for _, p := range []Panel{
+		{},
+		{KWp: 5, Tilt: 95},
+		{KWp: 5, Azimuth: -10},
+		{KWp: 5, Losses: 100},
+	} {
+		if err := p.Validate(); err == nil {
+			t.Errorf("expected error for %+v, got nil", p)
+		}
+	}
+}
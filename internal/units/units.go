@@ -21,6 +21,157 @@ func WindUnit(imperial bool) string {
 	return "km/h"
 }
 
+// PrecipUnit returns the precipitation amount unit suffix.
+func PrecipUnit(imperial bool) string {
+	if imperial {
+		return "in"
+	}
+	return "mm"
+}
+
+// FormatPrecip formats a precipitation amount with its unit.
+// Inches need two decimals to stay meaningful for light rain.
+func FormatPrecip(amount float64, imperial bool) string {
+	if imperial {
+		return fmt.Sprintf("%.2f%s", amount, PrecipUnit(imperial))
+	}
+	return fmt.Sprintf("%.1f%s", amount, PrecipUnit(imperial))
+}
+
+// HeightUnit returns the wave/length unit suffix.
+func HeightUnit(imperial bool) string {
+	if imperial {
+		return "ft"
+	}
+	return "m"
+}
+
+// FormatHeight formats a height given in meters, converting to feet for imperial.
+func FormatHeight(meters float64, imperial bool) string {
+	if imperial {
+		return fmt.Sprintf("%.1f%s", meters*3.28084, HeightUnit(imperial))
+	}
+	return fmt.Sprintf("%.1f%s", meters, HeightUnit(imperial))
+}
+
+// FormatElevation formats an elevation or altitude given in meters, in whole
+// meters or feet.
+func FormatElevation(meters float64, imperial bool) string {
+	if imperial {
+		return fmt.Sprintf("%.0f %s", meters*3.28084, HeightUnit(imperial))
+	}
+	return fmt.Sprintf("%.0f %s", meters, HeightUnit(imperial))
+}
+
+// ElevationToMeters converts an elevation entered in the active unit system
+// (meters, or feet for imperial) to meters.
+func ElevationToMeters(value float64, imperial bool) float64 {
+	if imperial {
+		return value / 3.28084
+	}
+	return value
+}
+
+// SnowUnit returns the snow depth unit suffix.
+func SnowUnit(imperial bool) string {
+	if imperial {
+		return "in"
+	}
+	return "cm"
+}
+
+// CentimetersToInches converts a length from cm to inches.
+func CentimetersToInches(cm float64) float64 {
+	return cm / 2.54
+}
+
+// MillimetersToInches converts a precipitation or water amount from mm to inches.
+func MillimetersToInches(mm float64) float64 {
+	return mm / 25.4
+}
+
+// FormatSnow formats a snow amount or depth given in cm, in cm or inches, with
+// one decimal below 10 and none above.
+func FormatSnow(cm float64, imperial bool) string {
+	v := cm
+	if imperial {
+		v = CentimetersToInches(cm)
+	}
+	if v < 10 {
+		return fmt.Sprintf("%.1f %s", v, SnowUnit(imperial))
+	}
+	return fmt.Sprintf("%.0f %s", v, SnowUnit(imperial))
+}
+
+// PressureUnit returns the air pressure unit suffix.
+func PressureUnit(imperial bool) string {
+	if imperial {
+		return "inHg"
+	}
+	return "hPa"
+}
+
+// ConvertPressure converts a pressure (or pressure change) given in hPa to the
+// unit system's pressure unit.
+func ConvertPressure(hpa float64, imperial bool) float64 {
+	if imperial {
+		return hpa * 0.0295300
+	}
+	return hpa
+}
+
+// FormatPressure formats a pressure given in hPa with its unit.
+// Inches of mercury need two decimals to show changes.
+func FormatPressure(hpa float64, imperial bool) string {
+	if imperial {
+		return fmt.Sprintf("%.2f %s", ConvertPressure(hpa, imperial), PressureUnit(imperial))
+	}
+	return fmt.Sprintf("%.0f %s", hpa, PressureUnit(imperial))
+}
+
+// DischargeUnit returns the river discharge unit suffix.
+func DischargeUnit(imperial bool) string {
+	if imperial {
+		return "ft³/s"
+	}
+	return "m³/s"
+}
+
+// ConvertDischarge converts a river discharge given in m³/s to the unit
+// system's discharge unit.
+func ConvertDischarge(m3s float64, imperial bool) float64 {
+	if imperial {
+		return m3s * 35.3147
+	}
+	return m3s
+}
+
+// DistanceUnit returns the distance unit suffix.
+func DistanceUnit(imperial bool) string {
+	if imperial {
+		return "mi"
+	}
+	return "km"
+}
+
+// FormatDistance formats a distance given in meters in km or miles, with one
+// decimal below 10 and none above.
+func FormatDistance(meters float64, imperial bool) string {
+	d := meters / 1000
+	if imperial {
+		d = meters / 1609.344
+	}
+	if d < 10 {
+		return fmt.Sprintf("%.1f %s", d, DistanceUnit(imperial))
+	}
+	return fmt.Sprintf("%.0f %s", d, DistanceUnit(imperial))
+}
+
+// CelsiusToFahrenheit converts a temperature from °C to °F.
+func CelsiusToFahrenheit(c float64) float64 {
+	return c*9/5 + 32
+}
+
 // FormatTemp formats a temperature value with its unit.
 func FormatTemp(temp float64, imperial bool) string {
 	return fmt.Sprintf("%.0f%s", temp, TempUnit(imperial))
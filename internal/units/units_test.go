@@ -2,6 +2,7 @@ package units
 
 import (
 	"goweather/internal/i18n"
+	"math"
 	"testing"
 )
 
@@ -47,6 +48,165 @@ func TestFormatTemp(t *testing.T) {
 	}
 }
 
+func TestFormatPrecip(t *testing.T) {
+	tests := []struct {
+		amount   float64
+		imperial bool
+		want     string
+	}{
+		{0, false, "0.0mm"},
+		{12.34, false, "12.3mm"},
+		{0.08, true, "0.08in"},
+		{1.5, true, "1.50in"},
+	}
+
+	for _, tt := range tests {
+		got := FormatPrecip(tt.amount, tt.imperial)
+		if got != tt.want {
+			t.Errorf("FormatPrecip(%f, %v) = %q, want %q", tt.amount, tt.imperial, got, tt.want)
+		}
+	}
+}
+
+func TestFormatHeight(t *testing.T) {
+	tests := []struct {
+		meters   float64
+		imperial bool
+		want     string
+	}{
+		{0.84, false, "0.8m"},
+		{1.48, false, "1.5m"},
+		{1, true, "3.3ft"},
+		{0, true, "0.0ft"},
+	}
+
+	for _, tt := range tests {
+		got := FormatHeight(tt.meters, tt.imperial)
+		if got != tt.want {
+			t.Errorf("FormatHeight(%f, %v) = %q, want %q", tt.meters, tt.imperial, got, tt.want)
+		}
+	}
+}
+
+func TestFormatElevation(t *testing.T) {
+	tests := []struct {
+		meters   float64
+		imperial bool
+		want     string
+	}{
+		{1608, false, "1608 m"},
+		{38.4, false, "38 m"},
+		{4478, true, "14692 ft"},
+		{0, true, "0 ft"},
+	}
+
+	for _, tt := range tests {
+		got := FormatElevation(tt.meters, tt.imperial)
+		if got != tt.want {
+			t.Errorf("FormatElevation(%f, %v) = %q, want %q", tt.meters, tt.imperial, got, tt.want)
+		}
+	}
+}
+
+func TestElevationToMeters(t *testing.T) {
+	if got := ElevationToMeters(1608, false); got != 1608 {
+		t.Errorf("ElevationToMeters(1608, false) = %f, want 1608", got)
+	}
+	if got := ElevationToMeters(14692, true); math.Abs(got-4478.2) > 0.1 {
+		t.Errorf("ElevationToMeters(14692, true) = %f, want 4478.2", got)
+	}
+}
+
+func TestFormatSnow(t *testing.T) {
+	tests := []struct {
+		cm       float64
+		imperial bool
+		want     string
+	}{
+		{18.2, false, "18 cm"},
+		{3.5, false, "3.5 cm"},
+		{0, false, "0.0 cm"},
+		{85, true, "33 in"},
+		{18.2, true, "7.2 in"},
+	}
+
+	for _, tt := range tests {
+		got := FormatSnow(tt.cm, tt.imperial)
+		if got != tt.want {
+			t.Errorf("FormatSnow(%f, %v) = %q, want %q", tt.cm, tt.imperial, got, tt.want)
+		}
+	}
+}
+
+func TestCentimetersToInches(t *testing.T) {
+	if got := CentimetersToInches(2.54); got != 1 {
+		t.Errorf("CentimetersToInches(2.54) = %f, want 1", got)
+	}
+	if got := MillimetersToInches(12.7); math.Abs(got-0.5) > 1e-9 {
+		t.Errorf("MillimetersToInches(12.7) = %f, want 0.5", got)
+	}
+}
+
+func TestConvertDischarge(t *testing.T) {
+	if got := ConvertDischarge(100, false); got != 100 {
+		t.Errorf("ConvertDischarge(100, metric) = %f, want 100", got)
+	}
+	if got := ConvertDischarge(100, true); math.Abs(got-3531.47) > 1e-9 {
+		t.Errorf("ConvertDischarge(100, imperial) = %f, want 3531.47", got)
+	}
+	if DischargeUnit(false) != "m³/s" || DischargeUnit(true) != "ft³/s" {
+		t.Errorf("DischargeUnit = %q/%q, want m³/s and ft³/s", DischargeUnit(false), DischargeUnit(true))
+	}
+}
+
+func TestFormatPressure(t *testing.T) {
+	tests := []struct {
+		hpa      float64
+		imperial bool
+		want     string
+	}{
+		{1012.6, false, "1013 hPa"},
+		{1013.25, true, "29.92 inHg"},
+		{985, true, "29.09 inHg"},
+	}
+
+	for _, tt := range tests {
+		got := FormatPressure(tt.hpa, tt.imperial)
+		if got != tt.want {
+			t.Errorf("FormatPressure(%f, %v) = %q, want %q", tt.hpa, tt.imperial, got, tt.want)
+		}
+	}
+}
+
+func TestFormatDistance(t *testing.T) {
+	tests := []struct {
+		meters   float64
+		imperial bool
+		want     string
+	}{
+		{24140, false, "24 km"},
+		{800, false, "0.8 km"},
+		{24140, true, "15 mi"},
+		{3000, true, "1.9 mi"},
+	}
+
+	for _, tt := range tests {
+		got := FormatDistance(tt.meters, tt.imperial)
+		if got != tt.want {
+			t.Errorf("FormatDistance(%f, %v) = %q, want %q", tt.meters, tt.imperial, got, tt.want)
+		}
+	}
+}
+
+func TestCelsiusToFahrenheit(t *testing.T) {
+	if got := CelsiusToFahrenheit(0); got != 32 {
+		t.Errorf("CelsiusToFahrenheit(0) = %f, want 32", got)
+	}
+	if got := CelsiusToFahrenheit(100); got != 212 {
+		t.Errorf("CelsiusToFahrenheit(100) = %f, want 212", got)
+	}
+}
+
 func TestWindCardinal(t *testing.T) {
 	tests := []struct {
 		degrees int
@@ -0,0 +1,80 @@
+// Package warnings derives severe-weather warnings from fetched weather data.
+package warnings
+
+import "goweather/internal/weather"
+
+// Thresholds configures when a warning is raised. Values are in the units of
+// the weather data (°C/km/h/mm for metric, °F/mph/in for imperial).
+type Thresholds struct {
+	Heat          float64 // daily maximum temperature at or above
+	Frost         float64 // daily minimum temperature at or below
+	Gust          float64 // daily maximum wind gust at or above
+	Precipitation float64 // daily precipitation sum at or above
+}
+
+// DefaultThresholds returns sensible thresholds for the unit system.
+func DefaultThresholds(imperial bool) Thresholds {
+	if imperial {
+		return Thresholds{Heat: 86, Frost: 14, Gust: 47, Precipitation: 1.2}
+	}
+	return Thresholds{Heat: 30, Frost: -10, Gust: 75, Precipitation: 30}
+}
+
+// isThunderstorm reports whether a WMO code is a thunderstorm (95-99).
+func isThunderstorm(code int) bool {
+	return code >= 95 && code <= 99
+}
+
+// Evaluate checks the current conditions and daily forecast against the
+// thresholds and returns at most one warning per kind, carrying the most
+// extreme value and the day it occurs. Warnings are ordered thunderstorm,
+// gust, precip, heat, frost.
+func Evaluate(data *weather.WeatherData, t Thresholds) []weather.Warning {
+	var heat, frost, gust, precip, storm *weather.Warning
+
+	// worst keeps the more extreme of the current warning and a new candidate
+	worst := func(w **weather.Warning, date string, value float64, higher bool) {
+		if *w == nil || (higher && value > (*w).Value) || (!higher && value < (*w).Value) {
+			*w = &weather.Warning{Date: date, Value: value}
+		}
+	}
+
+	for _, d := range data.Forecast() {
+		if d.TemperatureMax >= t.Heat {
+			worst(&heat, d.Date, d.TemperatureMax, true)
+		}
+		if d.TemperatureMin <= t.Frost {
+			worst(&frost, d.Date, d.TemperatureMin, false)
+		}
+		if d.WindGustsMax >= t.Gust {
+			worst(&gust, d.Date, d.WindGustsMax, true)
+		}
+		if d.PrecipitationSum >= t.Precipitation {
+			worst(&precip, d.Date, d.PrecipitationSum, true)
+		}
+		if isThunderstorm(d.WeatherCode) && storm == nil {
+			storm = &weather.Warning{Date: d.Date}
+		}
+	}
+
+	// A thunderstorm right now takes precedence over later days
+	if isThunderstorm(data.Current.WeatherCode) {
+		date := data.Current.Time
+		if len(date) >= 10 {
+			date = date[:10]
+		}
+		storm = &weather.Warning{Date: date}
+	}
+
+	var result []weather.Warning
+	for _, w := range []struct {
+		kind string
+		w    *weather.Warning
+	}{{"thunderstorm", storm}, {"gust", gust}, {"precip", precip}, {"heat", heat}, {"frost", frost}} {
+		if w.w != nil {
+			w.w.Kind = w.kind
+			result = append(result, *w.w)
+		}
+	}
+	return result
+}
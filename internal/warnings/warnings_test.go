@@ -0,0 +1,104 @@
+package warnings
+
+import (
+	"goweather/internal/weather"
+	"testing"
+)
+
+func TestEvaluateNoWarnings(t *testing.T) {
+	data := &weather.WeatherData{
+		Current: weather.CurrentWeather{WeatherCode: 1},
+		Daily: []weather.DailyForecast{
+			{Date: "2026-07-14", TemperatureMax: 24, TemperatureMin: 14, WindGustsMax: 30, PrecipitationSum: 2, WeatherCode: 61},
+		},
+	}
+
+	if got := Evaluate(data, DefaultThresholds(false)); len(got) != 0 {
+		t.Errorf("Evaluate() = %v, want no warnings", got)
+	}
+}
+
+func TestEvaluatePicksMostExtremeDay(t *testing.T) {
+	data := &weather.WeatherData{
+		Daily: []weather.DailyForecast{
+			{Date: "2026-07-14", TemperatureMax: 31, TemperatureMin: 18},
+			{Date: "2026-07-15", TemperatureMax: 35, TemperatureMin: 20},
+			{Date: "2026-07-16", TemperatureMax: 29, TemperatureMin: 17},
+		},
+	}
+
+	got := Evaluate(data, DefaultThresholds(false))
+	if len(got) != 1 {
+		t.Fatalf("warning count = %d, want 1: %v", len(got), got)
+	}
+	if got[0].Kind != "heat" || got[0].Date != "2026-07-15" || got[0].Value != 35 {
+		t.Errorf("warning = %+v, want heat 35 on 2026-07-15", got[0])
+	}
+}
+
+func TestEvaluateSkipsPastDays(t *testing.T) {
+	data := &weather.WeatherData{
+		Daily: []weather.DailyForecast{
+			{Date: "2026-07-13", TemperatureMax: 36, TemperatureMin: 21},
+			{Date: "2026-07-14", TemperatureMax: 24, TemperatureMin: 14},
+		},
+		PastDays: 1,
+	}
+
+	if got := Evaluate(data, DefaultThresholds(false)); len(got) != 0 {
+		t.Errorf("Evaluate() = %v, want no warnings for past days", got)
+	}
+}
+
+func TestEvaluateAllKinds(t *testing.T) {
+	data := &weather.WeatherData{
+		Current: weather.CurrentWeather{WeatherCode: 3, Time: "2026-01-10T12:00"},
+		Daily: []weather.DailyForecast{
+			{Date: "2026-01-10", TemperatureMax: -5, TemperatureMin: -14, WindGustsMax: 90, PrecipitationSum: 35},
+			{Date: "2026-01-11", TemperatureMax: -3, TemperatureMin: -16, WeatherCode: 96},
+		},
+	}
+	thresholds := DefaultThresholds(false)
+	thresholds.Heat = -4 // exercise a custom threshold
+
+	got := Evaluate(data, thresholds)
+	want := []weather.Warning{
+		{Kind: "thunderstorm", Date: "2026-01-11"},
+		{Kind: "gust", Date: "2026-01-10", Value: 90},
+		{Kind: "precip", Date: "2026-01-10", Value: 35},
+		{Kind: "heat", Date: "2026-01-11", Value: -3},
+		{Kind: "frost", Date: "2026-01-11", Value: -16},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("warnings = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("warning[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestEvaluateCurrentThunderstorm(t *testing.T) {
+	data := &weather.WeatherData{
+		Current: weather.CurrentWeather{WeatherCode: 95, Time: "2026-07-14T16:00"},
+		Daily: []weather.DailyForecast{
+			{Date: "2026-07-14", TemperatureMax: 25, TemperatureMin: 15},
+		},
+	}
+
+	got := Evaluate(data, DefaultThresholds(false))
+	if len(got) != 1 || got[0].Kind != "thunderstorm" || got[0].Date != "2026-07-14" {
+		t.Errorf("Evaluate() = %v, want thunderstorm on 2026-07-14", got)
+	}
+}
+
+func TestDefaultThresholdsImperial(t *testing.T) {
+	m, i := DefaultThresholds(false), DefaultThresholds(true)
+	if i.Heat != m.Heat*9/5+32 {
+		t.Errorf("imperial heat = %f, want %f", i.Heat, m.Heat*9/5+32)
+	}
+	if i.Frost != m.Frost*9/5+32 {
+		t.Errorf("imperial frost = %f, want %f", i.Frost, m.Frost*9/5+32)
+	}
+}
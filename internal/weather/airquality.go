@@ -0,0 +1,123 @@
+package weather
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const airQualityURL = "https://air-quality-api.open-meteo.com/v1/air-quality"
+
+// AirQualityClient fetches air quality data from the Open-Meteo air quality API.
+type AirQualityClient struct {
+	HTTPClient *http.Client
+	BaseURL    string
+}
+
+// NewAirQualityClient creates an air quality API client with default settings.
+func NewAirQualityClient() *AirQualityClient {
+	return &AirQualityClient{
+		HTTPClient: &http.Client{},
+		BaseURL:    airQualityURL,
+	}
+}
+
+// airQualityResponse mirrors the Open-Meteo air quality JSON structure.
+type airQualityResponse struct {
+	Current struct {
+		Time            string  `json:"time"`
+		PM25            float64 `json:"pm2_5"`
+		PM10            float64 `json:"pm10"`
+		Ozone           float64 `json:"ozone"`
+		NitrogenDioxide float64 `json:"nitrogen_dioxide"`
+		EuropeanAQI     int     `json:"european_aqi"`
+		USAQI           int     `json:"us_aqi"`
+	} `json:"current"`
+}
+
+// FetchAirQuality retrieves current pollutant concentrations and AQI values.
+func (c *AirQualityClient) FetchAirQuality(ctx context.Context, lat, lon float64) (*AirQuality, error) {
+	url := fmt.Sprintf(
+		"%s?latitude=%.4f&longitude=%.4f"+
+			"&current=pm2_5,pm10,ozone,nitrogen_dioxide,european_aqi,us_aqi"+
+			"&timezone=auto",
+		c.BaseURL, lat, lon,
+	)
+
+	var apiResp airQualityResponse
+	if err := getJSON(ctx, c.HTTPClient, url, "air quality", &apiResp); err != nil {
+		return nil, err
+	}
+
+	cur := apiResp.Current
+	return &AirQuality{
+		PM25:            cur.PM25,
+		PM10:            cur.PM10,
+		Ozone:           cur.Ozone,
+		NitrogenDioxide: cur.NitrogenDioxide,
+		EuropeanAQI:     cur.EuropeanAQI,
+		USAQI:           cur.USAQI,
+		Time:            cur.Time,
+	}, nil
+}
+
+// pollenResponse mirrors the hourly pollen block of the Open-Meteo air quality API.
+// Values are null outside the covered region (Europe), hence the pointers.
+type pollenResponse struct {
+	Hourly struct {
+		Time    []string   `json:"time"`
+		Alder   []*float64 `json:"alder_pollen"`
+		Birch   []*float64 `json:"birch_pollen"`
+		Grass   []*float64 `json:"grass_pollen"`
+		Mugwort []*float64 `json:"mugwort_pollen"`
+		Olive   []*float64 `json:"olive_pollen"`
+		Ragweed []*float64 `json:"ragweed_pollen"`
+	} `json:"hourly"`
+}
+
+// FetchPollen retrieves the pollen forecast and aggregates it to daily maxima.
+// The result has Available set to false when the region is not covered.
+func (c *AirQualityClient) FetchPollen(ctx context.Context, lat, lon float64, days int) (*PollenForecast, error) {
+	// The air quality API forecasts at most a week ahead
+	if days > 7 {
+		days = 7
+	}
+
+	url := fmt.Sprintf(
+		"%s?latitude=%.4f&longitude=%.4f"+
+			"&hourly=alder_pollen,birch_pollen,grass_pollen,mugwort_pollen,olive_pollen,ragweed_pollen"+
+			"&timezone=auto&forecast_days=%d",
+		c.BaseURL, lat, lon, days,
+	)
+
+	var apiResp pollenResponse
+	if err := getJSON(ctx, c.HTTPClient, url, "air quality", &apiResp); err != nil {
+		return nil, err
+	}
+
+	h := apiResp.Hourly
+	series := [len(PollenTypes)][]*float64{h.Alder, h.Birch, h.Grass, h.Mugwort, h.Olive, h.Ragweed}
+
+	forecast := &PollenForecast{}
+	for i, ts := range h.Time {
+		if len(ts) < 10 {
+			continue
+		}
+		date := ts[:10]
+		if n := len(forecast.Daily); n == 0 || forecast.Daily[n-1].Date != date {
+			forecast.Daily = append(forecast.Daily, DailyPollen{Date: date})
+		}
+		day := &forecast.Daily[len(forecast.Daily)-1]
+		for p, values := range series {
+			if i >= len(values) || values[i] == nil {
+				continue
+			}
+			forecast.Available = true
+			if *values[i] > day.Levels[p] {
+				day.Levels[p] = *values[i]
+			}
+		}
+	}
+
+	return forecast, nil
+}
@@ -0,0 +1,85 @@
+package weather
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const archiveURL = "https://archive-api.open-meteo.com/v1/archive"
+
+// ArchiveClient fetches observed past weather from the Open-Meteo historical archive API.
+type ArchiveClient struct {
+	HTTPClient *http.Client
+	BaseURL    string
+}
+
+// NewArchiveClient creates a historical archive API client with default settings.
+func NewArchiveClient() *ArchiveClient {
+	return &ArchiveClient{
+		HTTPClient: &http.Client{},
+		BaseURL:    archiveURL,
+	}
+}
+
+// archiveResponse mirrors the daily block of the Open-Meteo archive JSON structure.
+type archiveResponse struct {
+	Daily struct {
+		Time        []string   `json:"time"`
+		TempMax     []*float64 `json:"temperature_2m_max"`
+		TempMin     []*float64 `json:"temperature_2m_min"`
+		WeatherCode []*int     `json:"weather_code"`
+		PrecipSum   []*float64 `json:"precipitation_sum"`
+		PrecipHours []*float64 `json:"precipitation_hours"`
+	} `json:"daily"`
+}
+
+// FetchHistory retrieves observed daily weather for the inclusive date range
+// start..end (YYYY-MM-DD). Days the archive has not processed yet are skipped.
+func (c *ArchiveClient) FetchHistory(ctx context.Context, lat, lon float64, start, end string, imperial bool) ([]DailyForecast, error) {
+	tempUnit, windUnit, precipUnit := unitParams(imperial)
+
+	url := fmt.Sprintf(
+		"%s?latitude=%.4f&longitude=%.4f"+
+			"&start_date=%s&end_date=%s"+
+			"&daily=temperature_2m_max,temperature_2m_min,weather_code,precipitation_sum,precipitation_hours"+
+			"&timezone=auto"+
+			"&temperature_unit=%s&wind_speed_unit=%s&precipitation_unit=%s",
+		c.BaseURL, lat, lon, start, end, tempUnit, windUnit, precipUnit,
+	)
+
+	var apiResp archiveResponse
+	if err := getJSON(ctx, c.HTTPClient, url, "archive", &apiResp); err != nil {
+		return nil, err
+	}
+
+	d := apiResp.Daily
+	var daily []DailyForecast
+	for i := range d.Time {
+		// The archive lags a few days behind; recent days come back as null.
+		if i >= len(d.TempMax) || d.TempMax[i] == nil || i >= len(d.TempMin) || d.TempMin[i] == nil {
+			continue
+		}
+		day := DailyForecast{
+			Date:           d.Time[i],
+			TemperatureMax: *d.TempMax[i],
+			TemperatureMin: *d.TempMin[i],
+		}
+		if i < len(d.WeatherCode) && d.WeatherCode[i] != nil {
+			day.WeatherCode = *d.WeatherCode[i]
+		}
+		if i < len(d.PrecipSum) && d.PrecipSum[i] != nil {
+			day.PrecipitationSum = *d.PrecipSum[i]
+		}
+		if i < len(d.PrecipHours) && d.PrecipHours[i] != nil {
+			day.PrecipitationHours = *d.PrecipHours[i]
+		}
+		daily = append(daily, day)
+	}
+
+	if len(daily) == 0 {
+		return nil, fmt.Errorf("no observations available for %s to %s", start, end)
+	}
+
+	return daily, nil
+}
@@ -0,0 +1,44 @@
+package weather
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const elevationURL = "https://api.open-meteo.com/v1/elevation"
+
+// ElevationClient looks up terrain elevation from the Open-Meteo elevation API,
+// which is based on a 90 m digital elevation model.
+type ElevationClient struct {
+	HTTPClient *http.Client
+	BaseURL    string
+}
+
+// NewElevationClient creates an elevation API client with default settings.
+func NewElevationClient() *ElevationClient {
+	return &ElevationClient{
+		HTTPClient: &http.Client{},
+		BaseURL:    elevationURL,
+	}
+}
+
+// elevationResponse mirrors the Open-Meteo elevation JSON structure, which
+// holds one value per requested coordinate.
+type elevationResponse struct {
+	Elevation []float64 `json:"elevation"`
+}
+
+// FetchElevation returns the terrain elevation in meters above sea level.
+func (c *ElevationClient) FetchElevation(ctx context.Context, lat, lon float64) (float64, error) {
+	url := fmt.Sprintf("%s?latitude=%.4f&longitude=%.4f", c.BaseURL, lat, lon)
+
+	var apiResp elevationResponse
+	if err := getJSON(ctx, c.HTTPClient, url, "elevation", &apiResp); err != nil {
+		return 0, err
+	}
+	if len(apiResp.Elevation) == 0 {
+		return 0, fmt.Errorf("no elevation data for this location")
+	}
+	return apiResp.Elevation[0], nil
+}
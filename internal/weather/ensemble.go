@@ -0,0 +1,179 @@
+package weather
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+const ensembleURL = "https://ensemble-api.open-meteo.com/v1/ensemble"
+
+// ensembleModel is the ensemble queried; ICON-EPS has 40 members and covers
+// the globe for 7.5 days.
+const ensembleModel = "icon_seamless"
+
+// EnsembleMaxDays is the number of full days the ensemble covers.
+const EnsembleMaxDays = 7
+
+// EnsembleClient fetches ensemble forecasts from the Open-Meteo ensemble API.
+type EnsembleClient struct {
+	HTTPClient *http.Client
+	BaseURL    string
+	Elevation  *float64 // forecast elevation in meters; nil uses the terrain elevation
+}
+
+// NewEnsembleClient creates an ensemble API client with default settings.
+func NewEnsembleClient() *EnsembleClient {
+	return &EnsembleClient{
+		HTTPClient: &http.Client{},
+		BaseURL:    ensembleURL,
+	}
+}
+
+// SetElevation makes forecasts apply to the given elevation in meters.
+func (c *EnsembleClient) SetElevation(meters float64) {
+	c.Elevation = &meters
+}
+
+// ensembleResponse mirrors the Open-Meteo ensemble JSON structure. Every
+// member has its own hourly variables ("temperature_2m_member01", ...) next to
+// the control run ("temperature_2m"), so the hourly block is decoded by name.
+type ensembleResponse struct {
+	Timezone string                     `json:"timezone"`
+	Hourly   map[string]json.RawMessage `json:"hourly"`
+}
+
+// FetchEnsemble retrieves the hourly ensemble forecast and returns, per day,
+// the 10th/50th/90th percentiles across members of the daily max/min
+// temperature and the daily precipitation sum.
+func (c *EnsembleClient) FetchEnsemble(ctx context.Context, lat, lon float64, days int, imperial bool) ([]DailyEnsemble, error) {
+	if days > EnsembleMaxDays {
+		return nil, fmt.Errorf("the ensemble covers at most %d days (got %d)", EnsembleMaxDays, days)
+	}
+	tempUnit, windUnit, precipUnit := unitParams(imperial)
+
+	url := fmt.Sprintf(
+		"%s?latitude=%.4f&longitude=%.4f"+
+			"&hourly=temperature_2m,precipitation&models=%s"+
+			"&timezone=auto&forecast_days=%d"+
+			"&temperature_unit=%s&wind_speed_unit=%s&precipitation_unit=%s%s",
+		c.BaseURL, lat, lon, ensembleModel, days, tempUnit, windUnit, precipUnit, elevationParam(c.Elevation),
+	)
+
+	var apiResp ensembleResponse
+	if err := getJSON(ctx, c.HTTPClient, url, "ensemble", &apiResp); err != nil {
+		return nil, err
+	}
+
+	var times []string
+	if err := decodeHourly(apiResp.Hourly, "time", &times); err != nil {
+		return nil, err
+	}
+
+	// Per day, the daily max/min/sum of each member
+	maxes, mins, sums := map[string][]float64{}, map[string][]float64{}, map[string][]float64{}
+	var dates []string
+
+	members := 0
+	for key := range apiResp.Hourly {
+		if key != "temperature_2m" && !strings.HasPrefix(key, "temperature_2m_member") {
+			continue
+		}
+		suffix := strings.TrimPrefix(key, "temperature_2m")
+
+		var temps, precip []*float64
+		if err := decodeHourly(apiResp.Hourly, key, &temps); err != nil {
+			return nil, err
+		}
+		if err := decodeHourly(apiResp.Hourly, "precipitation"+suffix, &precip); err != nil {
+			return nil, err
+		}
+
+		dayMax, dayMin, daySum := map[string]float64{}, map[string]float64{}, map[string]float64{}
+		for i, ts := range times {
+			if i >= len(temps) || temps[i] == nil || len(ts) < 10 {
+				continue
+			}
+			date := ts[:10]
+			t := *temps[i]
+			if cur, ok := dayMax[date]; !ok || t > cur {
+				dayMax[date] = t
+			}
+			if cur, ok := dayMin[date]; !ok || t < cur {
+				dayMin[date] = t
+			}
+			if i < len(precip) && precip[i] != nil {
+				daySum[date] += *precip[i]
+			}
+		}
+		if len(dayMax) == 0 {
+			continue
+		}
+		members++
+
+		for date, v := range dayMax {
+			if _, ok := maxes[date]; !ok {
+				dates = append(dates, date)
+			}
+			maxes[date] = append(maxes[date], v)
+			mins[date] = append(mins[date], dayMin[date])
+			sums[date] = append(sums[date], daySum[date])
+		}
+	}
+
+	if members == 0 {
+		return nil, fmt.Errorf("ensemble API returned no member data")
+	}
+
+	sort.Strings(dates)
+	result := make([]DailyEnsemble, 0, len(dates))
+	for _, date := range dates {
+		result = append(result, DailyEnsemble{
+			Date:           date,
+			TemperatureMax: percentiles(maxes[date]),
+			TemperatureMin: percentiles(mins[date]),
+			Precipitation:  percentiles(sums[date]),
+			Members:        len(maxes[date]),
+		})
+	}
+	return result, nil
+}
+
+// decodeHourly decodes the hourly variable name into v; a missing variable leaves v unchanged.
+func decodeHourly(hourly map[string]json.RawMessage, name string, v interface{}) error {
+	raw, ok := hourly[name]
+	if !ok {
+		return nil
+	}
+	if err := json.Unmarshal(raw, v); err != nil {
+		return fmt.Errorf("failed to parse ensemble response: %s: %w", name, err)
+	}
+	return nil
+}
+
+// percentiles returns the 10th, 50th and 90th percentiles of values.
+func percentiles(values []float64) Percentiles {
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+	return Percentiles{
+		P10: percentile(sorted, 10),
+		P50: percentile(sorted, 50),
+		P90: percentile(sorted, 90),
+	}
+}
+
+// percentile returns the p-th percentile of sorted values, interpolating
+// linearly between the closest ranks (0 for no values).
+func percentile(sorted []float64, p float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := p / 100 * float64(len(sorted)-1)
+	lo := int(math.Floor(rank))
+	hi := int(math.Ceil(rank))
+	return sorted[lo] + (sorted[hi]-sorted[lo])*(rank-float64(lo))
+}
@@ -0,0 +1,74 @@
+package weather
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const floodURL = "https://flood-api.open-meteo.com/v1/flood"
+
+// FloodClient fetches river discharge from the Open-Meteo flood API, which
+// serves GloFAS forecasts and reanalysis for the river nearest to a
+// coordinate. Discharge is in m³/s.
+type FloodClient struct {
+	HTTPClient *http.Client
+	BaseURL    string
+}
+
+// NewFloodClient creates a flood API client with default settings.
+func NewFloodClient() *FloodClient {
+	return &FloodClient{
+		HTTPClient: &http.Client{},
+		BaseURL:    floodURL,
+	}
+}
+
+// floodResponse mirrors the Open-Meteo flood JSON structure. Discharge is
+// null away from rivers and on days without data.
+type floodResponse struct {
+	Daily struct {
+		Time      []string   `json:"time"`
+		Discharge []*float64 `json:"river_discharge"`
+	} `json:"daily"`
+}
+
+// FetchDischarge retrieves the daily river discharge forecast starting today.
+func (c *FloodClient) FetchDischarge(ctx context.Context, lat, lon float64, days int) ([]DailyDischarge, error) {
+	url := fmt.Sprintf(
+		"%s?latitude=%.4f&longitude=%.4f&daily=river_discharge&forecast_days=%d",
+		c.BaseURL, lat, lon, days,
+	)
+	return c.fetch(ctx, url)
+}
+
+// FetchDischargeHistory retrieves the modelled daily river discharge for the
+// inclusive date range start..end (YYYY-MM-DD).
+func (c *FloodClient) FetchDischargeHistory(ctx context.Context, lat, lon float64, start, end string) ([]DailyDischarge, error) {
+	url := fmt.Sprintf(
+		"%s?latitude=%.4f&longitude=%.4f&daily=river_discharge&start_date=%s&end_date=%s",
+		c.BaseURL, lat, lon, start, end,
+	)
+	return c.fetch(ctx, url)
+}
+
+// fetch requests url and returns the days that have a discharge value.
+func (c *FloodClient) fetch(ctx context.Context, url string) ([]DailyDischarge, error) {
+	var apiResp floodResponse
+	if err := getJSON(ctx, c.HTTPClient, url, "flood", &apiResp); err != nil {
+		return nil, err
+	}
+
+	d := apiResp.Daily
+	var days []DailyDischarge
+	for i := range d.Time {
+		if i >= len(d.Discharge) || d.Discharge[i] == nil {
+			continue
+		}
+		days = append(days, DailyDischarge{Date: d.Time[i], Discharge: *d.Discharge[i]})
+	}
+	if len(days) == 0 {
+		return nil, fmt.Errorf("no river discharge data for this location")
+	}
+	return days, nil
+}
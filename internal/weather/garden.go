@@ -0,0 +1,112 @@
+package weather
+
+import (
+	"context"
+	"fmt"
+)
+
+// gardenResponse mirrors the soil and evapotranspiration variables of the
+// Open-Meteo JSON structure. Soil values are null for models that lack them.
+type gardenResponse struct {
+	Elevation float64 `json:"elevation"`
+	Timezone  string  `json:"timezone"`
+	Current   struct {
+		SoilTemperature0cm  *float64 `json:"soil_temperature_0cm"`
+		SoilTemperature6cm  *float64 `json:"soil_temperature_6cm"`
+		SoilTemperature18cm *float64 `json:"soil_temperature_18cm"`
+		SoilTemperature54cm *float64 `json:"soil_temperature_54cm"`
+		SoilMoisture0to1cm  *float64 `json:"soil_moisture_0_to_1cm"`
+		SoilMoisture3to9cm  *float64 `json:"soil_moisture_3_to_9cm"`
+		SoilMoisture9to27cm *float64 `json:"soil_moisture_9_to_27cm"`
+		SoilMoisture27to81  *float64 `json:"soil_moisture_27_to_81cm"`
+	} `json:"current"`
+	Daily struct {
+		Time        []string  `json:"time"`
+		TempMax     []float64 `json:"temperature_2m_max"`
+		TempMin     []float64 `json:"temperature_2m_min"`
+		PrecipSum   []float64 `json:"precipitation_sum"`
+		HumidityMax []float64 `json:"relative_humidity_2m_max"`
+		HumidityMin []float64 `json:"relative_humidity_2m_min"`
+		WindMean    []float64 `json:"wind_speed_10m_mean"`
+		Radiation   []float64 `json:"shortwave_radiation_sum"`
+	} `json:"daily"`
+}
+
+// FetchGarden retrieves the current soil conditions and, per day, the inputs
+// of a FAO-56 evapotranspiration estimate: temperature, humidity, wind and
+// radiation, with the precipitation. The daily forecast starts with the given
+// number of past days. Values are always requested in metric units, and the
+// elevation of the grid cell is included.
+func (c *Client) FetchGarden(ctx context.Context, lat, lon float64, pastDays, days int) (*WeatherData, error) {
+	url := fmt.Sprintf(
+		"%s?latitude=%.4f&longitude=%.4f"+
+			"&current=soil_temperature_0cm,soil_temperature_6cm,soil_temperature_18cm,soil_temperature_54cm"+
+			",soil_moisture_0_to_1cm,soil_moisture_3_to_9cm,soil_moisture_9_to_27cm,soil_moisture_27_to_81cm"+
+			"&daily=temperature_2m_max,temperature_2m_min,precipitation_sum"+
+			",relative_humidity_2m_max,relative_humidity_2m_min,wind_speed_10m_mean,shortwave_radiation_sum"+
+			"&timezone=auto&past_days=%d&forecast_days=%d%s",
+		c.BaseURL, lat, lon, pastDays, days, elevationParam(c.Elevation),
+	)
+
+	var apiResp gardenResponse
+	if err := getJSON(ctx, c.HTTPClient, url, "weather", &apiResp); err != nil {
+		return nil, err
+	}
+
+	// Each temperature depth is paired with the moisture layer around it
+	cur := apiResp.Current
+	var soil []SoilLevel
+	for _, l := range []struct {
+		depth                 int
+		temperature, moisture *float64
+	}{
+		{0, cur.SoilTemperature0cm, cur.SoilMoisture0to1cm},
+		{6, cur.SoilTemperature6cm, cur.SoilMoisture3to9cm},
+		{18, cur.SoilTemperature18cm, cur.SoilMoisture9to27cm},
+		{54, cur.SoilTemperature54cm, cur.SoilMoisture27to81},
+	} {
+		if l.temperature == nil || l.moisture == nil {
+			continue
+		}
+		soil = append(soil, SoilLevel{Depth: l.depth, Temperature: *l.temperature, Moisture: *l.moisture})
+	}
+
+	// The water balance needs the temperatures of every day
+	d := apiResp.Daily
+	if len(d.TempMax) < len(d.Time) || len(d.TempMin) < len(d.Time) {
+		return nil, fmt.Errorf("weather API returned incomplete daily data")
+	}
+	daily := make([]DailyForecast, len(d.Time))
+	for i := range d.Time {
+		daily[i] = DailyForecast{Date: d.Time[i], TemperatureMax: d.TempMax[i], TemperatureMin: d.TempMin[i]}
+		// Optional fields may be missing for some models; leave them zero.
+		if i < len(d.PrecipSum) {
+			daily[i].PrecipitationSum = d.PrecipSum[i]
+		}
+		if i < len(d.HumidityMax) {
+			daily[i].RelativeHumidityMax = d.HumidityMax[i]
+		}
+		if i < len(d.HumidityMin) {
+			daily[i].RelativeHumidityMin = d.HumidityMin[i]
+		}
+		if i < len(d.WindMean) {
+			daily[i].WindSpeedMean = d.WindMean[i]
+		}
+		if i < len(d.Radiation) {
+			daily[i].ShortwaveRadiationSum = d.Radiation[i]
+		}
+	}
+
+	if pastDays > len(daily) {
+		pastDays = len(daily)
+	}
+
+	return &WeatherData{
+		Daily:        daily,
+		PastDays:     pastDays,
+		Soil:         soil,
+		Timezone:     apiResp.Timezone,
+		Elevation:    apiResp.Elevation,
+		HasElevation: true,
+	}, nil
+}
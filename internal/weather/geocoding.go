@@ -1,11 +1,11 @@
 package weather
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
-	"time"
 )
 
 const geocodingURL = "https://geocoding-api.open-meteo.com/v1/search"
@@ -19,15 +19,33 @@ type geocodingResponse struct {
 	} `json:"results"`
 }
 
-// GeocodeCity resolves a city name to coordinates using Open-Meteo geocoding.
-func GeocodeCity(name string) (lat, lon float64, city, country string, err error) {
-	return geocodeCityWithClient(name, &http.Client{Timeout: 5 * time.Second}, geocodingURL)
+// GeocodingClient resolves city names using Open-Meteo geocoding.
+type GeocodingClient struct {
+	HTTPClient *http.Client
+	BaseURL    string
 }
 
-func geocodeCityWithClient(name string, client *http.Client, baseURL string) (lat, lon float64, city, country string, err error) {
+// NewGeocodingClient creates a geocoding API client with default settings.
+func NewGeocodingClient() *GeocodingClient {
+	return &GeocodingClient{
+		HTTPClient: &http.Client{},
+		BaseURL:    geocodingURL,
+	}
+}
+
+// GeocodeCity resolves a city name to coordinates.
+func (c *GeocodingClient) GeocodeCity(ctx context.Context, name string) (lat, lon float64, city, country string, err error) {
+	return geocodeCityWithClient(ctx, name, c.HTTPClient, c.BaseURL)
+}
+
+func geocodeCityWithClient(ctx context.Context, name string, client *http.Client, baseURL string) (lat, lon float64, city, country string, err error) {
 	u := fmt.Sprintf("%s?name=%s&count=1&language=en&format=json", baseURL, url.QueryEscape(name))
 
-	resp, err := client.Get(u)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return 0, 0, "", "", fmt.Errorf("geocoding request failed: %w", err)
+	}
+	resp, err := client.Do(req)
 	if err != nil {
 		return 0, 0, "", "", fmt.Errorf("geocoding request failed: %w", err)
 	}
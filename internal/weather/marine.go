@@ -0,0 +1,100 @@
+package weather
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const marineURL = "https://marine-api.open-meteo.com/v1/marine"
+
+// MarineClient fetches sea state data from the Open-Meteo marine API.
+// Values are always requested in metric units (meters, °C).
+type MarineClient struct {
+	HTTPClient *http.Client
+	BaseURL    string
+}
+
+// NewMarineClient creates a marine API client with default settings.
+func NewMarineClient() *MarineClient {
+	return &MarineClient{
+		HTTPClient: &http.Client{},
+		BaseURL:    marineURL,
+	}
+}
+
+// marineResponse mirrors the Open-Meteo marine JSON structure.
+// Wave height is a pointer because it is null for locations away from the sea.
+type marineResponse struct {
+	Current struct {
+		Time               string   `json:"time"`
+		WaveHeight         *float64 `json:"wave_height"`
+		WaveDirection      int      `json:"wave_direction"`
+		WavePeriod         float64  `json:"wave_period"`
+		SwellWaveHeight    float64  `json:"swell_wave_height"`
+		SwellWaveDirection int      `json:"swell_wave_direction"`
+		SwellWavePeriod    float64  `json:"swell_wave_period"`
+		SeaSurfaceTemp     float64  `json:"sea_surface_temperature"`
+	} `json:"current"`
+	Daily struct {
+		Time               []string  `json:"time"`
+		WaveHeightMax      []float64 `json:"wave_height_max"`
+		WaveDirection      []int     `json:"wave_direction_dominant"`
+		WavePeriodMax      []float64 `json:"wave_period_max"`
+		SwellWaveHeightMax []float64 `json:"swell_wave_height_max"`
+	} `json:"daily"`
+}
+
+// FetchMarine retrieves current sea conditions and the daily marine forecast.
+func (c *MarineClient) FetchMarine(ctx context.Context, lat, lon float64, days int) (*MarineData, error) {
+	url := fmt.Sprintf(
+		"%s?latitude=%.4f&longitude=%.4f"+
+			"&current=wave_height,wave_direction,wave_period,swell_wave_height,swell_wave_direction,swell_wave_period,sea_surface_temperature"+
+			"&daily=wave_height_max,wave_direction_dominant,wave_period_max,swell_wave_height_max"+
+			"&timezone=auto&forecast_days=%d",
+		c.BaseURL, lat, lon, days,
+	)
+
+	var apiResp marineResponse
+	if err := getJSON(ctx, c.HTTPClient, url, "marine", &apiResp); err != nil {
+		return nil, err
+	}
+
+	cur := apiResp.Current
+	if cur.WaveHeight == nil {
+		return nil, fmt.Errorf("no marine data for this location")
+	}
+
+	d := apiResp.Daily
+	daily := make([]DailyMarine, len(d.Time))
+	for i := range d.Time {
+		daily[i] = DailyMarine{Date: d.Time[i]}
+		// Values missing for some days are left zero
+		if i < len(d.WaveHeightMax) {
+			daily[i].WaveHeightMax = d.WaveHeightMax[i]
+		}
+		if i < len(d.WaveDirection) {
+			daily[i].WaveDirectionDominant = d.WaveDirection[i]
+		}
+		if i < len(d.WavePeriodMax) {
+			daily[i].WavePeriodMax = d.WavePeriodMax[i]
+		}
+		if i < len(d.SwellWaveHeightMax) {
+			daily[i].SwellWaveHeightMax = d.SwellWaveHeightMax[i]
+		}
+	}
+
+	return &MarineData{
+		Current: MarineConditions{
+			WaveHeight:            *cur.WaveHeight,
+			WaveDirection:         cur.WaveDirection,
+			WavePeriod:            cur.WavePeriod,
+			SwellWaveHeight:       cur.SwellWaveHeight,
+			SwellWaveDirection:    cur.SwellWaveDirection,
+			SwellWavePeriod:       cur.SwellWavePeriod,
+			SeaSurfaceTemperature: cur.SeaSurfaceTemp,
+			Time:                  cur.Time,
+		},
+		Daily: daily,
+	}, nil
+}
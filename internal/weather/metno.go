@@ -0,0 +1,233 @@
+package weather
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const metnoURL = "https://api.met.no/weatherapi/locationforecast/2.0/compact"
+
+// MetNoClient fetches weather data from the MET Norway locationforecast API.
+// The API serves metric values in UTC; days are grouped by the local date,
+// see metnoZone.
+type MetNoClient struct {
+	HTTPClient *http.Client
+	BaseURL    string
+	Elevation  *float64 // forecast altitude in meters; nil uses MET Norway's terrain model
+}
+
+// NewMetNoClient creates a MET Norway API client with default settings.
+func NewMetNoClient() *MetNoClient {
+	return &MetNoClient{
+		HTTPClient: &http.Client{},
+		BaseURL:    metnoURL,
+	}
+}
+
+// SetElevation makes forecasts apply to the given altitude in meters.
+func (c *MetNoClient) SetElevation(meters float64) {
+	c.Elevation = &meters
+}
+
+// metnoResponse mirrors the MET Norway locationforecast (compact) JSON structure.
+type metnoResponse struct {
+	Properties struct {
+		Timeseries []struct {
+			Time string `json:"time"`
+			Data struct {
+				Instant struct {
+					Details struct {
+						AirTemperature    float64 `json:"air_temperature"`
+						RelativeHumidity  float64 `json:"relative_humidity"`
+						WindFromDirection float64 `json:"wind_from_direction"`
+						WindSpeed         float64 `json:"wind_speed"`
+					} `json:"details"`
+				} `json:"instant"`
+				Next1Hours  *metnoPeriod `json:"next_1_hours"`
+				Next6Hours  *metnoPeriod `json:"next_6_hours"`
+				Next12Hours *metnoPeriod `json:"next_12_hours"`
+			} `json:"data"`
+		} `json:"timeseries"`
+	} `json:"properties"`
+}
+
+// metnoPeriod is the summary of one of the next_N_hours blocks.
+type metnoPeriod struct {
+	Summary struct {
+		SymbolCode string `json:"symbol_code"`
+	} `json:"summary"`
+	Details struct {
+		PrecipitationAmount float64 `json:"precipitation_amount"`
+	} `json:"details"`
+}
+
+// FetchWeather retrieves current weather and a daily forecast aggregated from
+// the hourly (later 6-hourly) time series.
+func (c *MetNoClient) FetchWeather(ctx context.Context, lat, lon float64, days int, imperial bool) (*WeatherData, error) {
+	url := fmt.Sprintf("%s?lat=%.4f&lon=%.4f", c.BaseURL, lat, lon)
+	if c.Elevation != nil {
+		url += fmt.Sprintf("&altitude=%.0f", *c.Elevation)
+	}
+
+	var apiResp metnoResponse
+	if err := getJSON(ctx, c.HTTPClient, url, "MET Norway", &apiResp); err != nil {
+		return nil, err
+	}
+
+	series := apiResp.Properties.Timeseries
+	if len(series) == 0 {
+		return nil, fmt.Errorf("MET Norway returned no forecast data")
+	}
+
+	windFactor := kmhPerMs
+	if imperial {
+		windFactor = mphPerMs
+	}
+
+	now := series[0].Data
+	current := CurrentWeather{
+		Temperature:   celsius(now.Instant.Details.AirTemperature, imperial),
+		Humidity:      int(now.Instant.Details.RelativeHumidity + 0.5),
+		WindSpeed:     now.Instant.Details.WindSpeed * windFactor,
+		WindDirection: int(now.Instant.Details.WindFromDirection + 0.5),
+	}
+	zone := metnoZone(lon)
+	if t, err := time.Parse(time.RFC3339, series[0].Time); err == nil {
+		current.Time = t.In(zone).Format("2006-01-02T15:04")
+	}
+	if p := firstPeriod(now.Next1Hours, now.Next6Hours, now.Next12Hours); p != nil {
+		current.WeatherCode = metnoWeatherCode(p.Summary.SymbolCode)
+	}
+
+	var daily []DailyForecast
+	for _, entry := range series {
+		t, err := time.Parse(time.RFC3339, entry.Time)
+		if err != nil {
+			continue
+		}
+		date := t.In(zone).Format("2006-01-02")
+		if len(daily) == 0 || daily[len(daily)-1].Date != date {
+			if len(daily) == days {
+				break
+			}
+			temp := entry.Data.Instant.Details.AirTemperature
+			daily = append(daily, DailyForecast{Date: date, TemperatureMax: temp, TemperatureMin: temp})
+		}
+		day := &daily[len(daily)-1]
+
+		temp := entry.Data.Instant.Details.AirTemperature
+		if temp > day.TemperatureMax {
+			day.TemperatureMax = temp
+		}
+		if temp < day.TemperatureMin {
+			day.TemperatureMin = temp
+		}
+
+		// Hourly steps carry next_1_hours; the 6-hourly tail of the series
+		// only has next_6_hours, which then covers the gap to the next step.
+		p := firstPeriod(entry.Data.Next1Hours, entry.Data.Next6Hours, entry.Data.Next12Hours)
+		if p == nil {
+			continue
+		}
+		if entry.Data.Next1Hours != nil || entry.Data.Next6Hours != nil {
+			day.PrecipitationSum += p.Details.PrecipitationAmount
+		}
+		if code := metnoWeatherCode(p.Summary.SymbolCode); moreSevere(code, day.WeatherCode) {
+			day.WeatherCode = code
+		}
+	}
+
+	for i := range daily {
+		daily[i].TemperatureMax = celsius(daily[i].TemperatureMax, imperial)
+		daily[i].TemperatureMin = celsius(daily[i].TemperatureMin, imperial)
+		daily[i].PrecipitationSum = millimeters(daily[i].PrecipitationSum, imperial)
+	}
+
+	return &WeatherData{
+		Current:  current,
+		Daily:    daily,
+		Timezone: zone.String(),
+	}, nil
+}
+
+// metnoZone approximates the time zone at a longitude by its solar time,
+// rounded to whole hours, as MET Norway gives no time zone. Civil time can
+// differ by an hour or so, e.g. during daylight saving time, but days no
+// longer start in the middle of the local night far from UTC.
+func metnoZone(lon float64) *time.Location {
+	hours := int(math.Round(lon / 15))
+	if hours == 0 {
+		return time.UTC
+	}
+	return time.FixedZone(fmt.Sprintf("UTC%+d", hours), hours*3600)
+}
+
+// firstPeriod returns the first of the given periods that is present.
+func firstPeriod(periods ...*metnoPeriod) *metnoPeriod {
+	for _, p := range periods {
+		if p != nil {
+			return p
+		}
+	}
+	return nil
+}
+
+// metnoSymbols maps MET Norway symbol codes (without the _day/_night/_polartwilight
+// variant suffix) to the closest WMO weather code. The "lights..." spellings are
+// MET Norway's own and must be kept as is.
+var metnoSymbols = map[string]int{
+	"clearsky":                     0,
+	"fair":                         1,
+	"partlycloudy":                 2,
+	"cloudy":                       3,
+	"fog":                          45,
+	"lightrain":                    61,
+	"rain":                         63,
+	"heavyrain":                    65,
+	"lightrainshowers":             80,
+	"rainshowers":                  81,
+	"heavyrainshowers":             82,
+	"lightsleet":                   66,
+	"sleet":                        66,
+	"heavysleet":                   67,
+	"lightsleetshowers":            66,
+	"sleetshowers":                 66,
+	"heavysleetshowers":            67,
+	"lightsnow":                    71,
+	"snow":                         73,
+	"heavysnow":                    75,
+	"lightsnowshowers":             85,
+	"snowshowers":                  85,
+	"heavysnowshowers":             86,
+	"lightrainandthunder":          95,
+	"rainandthunder":               95,
+	"heavyrainandthunder":          95,
+	"lightrainshowersandthunder":   95,
+	"rainshowersandthunder":        95,
+	"heavyrainshowersandthunder":   95,
+	"lightsleetandthunder":         95,
+	"sleetandthunder":              95,
+	"heavysleetandthunder":         95,
+	"lightssleetshowersandthunder": 95,
+	"sleetshowersandthunder":       95,
+	"heavysleetshowersandthunder":  95,
+	"lightsnowandthunder":          95,
+	"snowandthunder":               95,
+	"heavysnowandthunder":          95,
+	"lightssnowshowersandthunder":  95,
+	"snowshowersandthunder":        95,
+	"heavysnowshowersandthunder":   95,
+}
+
+// metnoWeatherCode maps a MET Norway symbol code such as "rainshowers_day" to a WMO code.
+// Unknown symbols map to 0 (clear sky).
+func metnoWeatherCode(symbol string) int {
+	if i := strings.IndexByte(symbol, '_'); i >= 0 {
+		symbol = symbol[:i]
+	}
+	return metnoSymbols[symbol]
+}
@@ -0,0 +1,74 @@
+package weather
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// modelAliases maps the short model names accepted on the command line to
+// Open-Meteo model identifiers.
+var modelAliases = map[string]string{
+	"icon":        "icon_seamless",
+	"gfs":         "gfs_seamless",
+	"ecmwf":       "ecmwf_ifs025",
+	"meteofrance": "meteofrance_seamless",
+	"jma":         "jma_seamless",
+}
+
+// modelLabels are the display names of the supported Open-Meteo models.
+var modelLabels = map[string]string{
+	"icon_seamless":        "ICON",
+	"gfs_seamless":         "GFS",
+	"ecmwf_ifs025":         "ECMWF IFS",
+	"meteofrance_seamless": "Météo-France",
+	"jma_seamless":         "JMA",
+}
+
+// ModelForecast holds the daily forecast of a single weather model.
+// Days beyond the model's forecast horizon are omitted.
+type ModelForecast struct {
+	Model string // Open-Meteo model identifier, e.g. "icon_seamless"
+	Daily []DailyForecast
+}
+
+// ResolveModels converts a comma-separated list of model names (short aliases
+// such as "icon" or Open-Meteo identifiers) to Open-Meteo identifiers.
+func ResolveModels(list string) ([]string, error) {
+	var models []string
+	for _, name := range strings.Split(list, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		if id, ok := modelAliases[name]; ok {
+			name = id
+		}
+		if _, ok := modelLabels[name]; !ok {
+			return nil, fmt.Errorf("unknown model %q (available: %s)", name, strings.Join(modelNames(), ", "))
+		}
+		models = append(models, name)
+	}
+	if len(models) == 0 {
+		return nil, fmt.Errorf("no models given")
+	}
+	return models, nil
+}
+
+// ModelLabel returns the display name of an Open-Meteo model identifier.
+func ModelLabel(model string) string {
+	if label, ok := modelLabels[model]; ok {
+		return label
+	}
+	return model
+}
+
+// modelNames returns the sorted short model names.
+func modelNames() []string {
+	names := make([]string, 0, len(modelAliases))
+	for name := range modelAliases {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
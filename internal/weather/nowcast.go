@@ -0,0 +1,54 @@
+package weather
+
+import (
+	"context"
+	"fmt"
+)
+
+// nowcastSteps is the number of 15-minute steps requested: the current
+// interval plus the next two hours.
+const nowcastSteps = 9
+
+// nowcastResponse mirrors the minutely_15 block of the Open-Meteo JSON structure.
+type nowcastResponse struct {
+	Current struct {
+		Time string `json:"time"`
+	} `json:"current"`
+	Minutely15 struct {
+		Time          []string   `json:"time"`
+		Precipitation []*float64 `json:"precipitation"`
+	} `json:"minutely_15"`
+}
+
+// FetchNowcast retrieves the precipitation forecast for the next two hours in
+// 15-minute steps. Steps without data are skipped.
+func (c *Client) FetchNowcast(ctx context.Context, lat, lon float64, imperial bool) (*Nowcast, error) {
+	_, _, precipUnit := unitParams(imperial)
+
+	url := fmt.Sprintf(
+		"%s?latitude=%.4f&longitude=%.4f"+
+			"&current=precipitation&minutely_15=precipitation"+
+			"&timezone=auto&forecast_minutely_15=%d"+
+			"&precipitation_unit=%s%s",
+		c.BaseURL, lat, lon, nowcastSteps, precipUnit, elevationParam(c.Elevation),
+	)
+
+	var apiResp nowcastResponse
+	if err := getJSON(ctx, c.HTTPClient, url, "weather", &apiResp); err != nil {
+		return nil, err
+	}
+
+	m := apiResp.Minutely15
+	nowcast := &Nowcast{Time: apiResp.Current.Time}
+	for i, ts := range m.Time {
+		if i >= len(m.Precipitation) || m.Precipitation[i] == nil {
+			continue
+		}
+		nowcast.Steps = append(nowcast.Steps, NowcastStep{Time: ts, Precipitation: *m.Precipitation[i]})
+	}
+
+	if len(nowcast.Steps) == 0 {
+		return nil, fmt.Errorf("no nowcast data for this location")
+	}
+	return nowcast, nil
+}
@@ -0,0 +1,228 @@
+package weather
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+const nwsURL = "https://api.weather.gov"
+
+// NWSClient fetches weather data from the US National Weather Service API.
+// It only covers the United States and its territories.
+type NWSClient struct {
+	HTTPClient *http.Client
+	BaseURL    string
+}
+
+// NewNWSClient creates an NWS API client with default settings.
+func NewNWSClient() *NWSClient {
+	return &NWSClient{
+		HTTPClient: &http.Client{},
+		BaseURL:    nwsURL,
+	}
+}
+
+// nwsPointsResponse mirrors the NWS /points JSON structure, which links a
+// coordinate to the forecast grid serving it.
+type nwsPointsResponse struct {
+	Properties struct {
+		ForecastHourly string `json:"forecastHourly"`
+		TimeZone       string `json:"timeZone"`
+	} `json:"properties"`
+}
+
+// nwsForecastResponse mirrors the NWS hourly forecast JSON structure.
+type nwsForecastResponse struct {
+	Properties struct {
+		Periods []struct {
+			StartTime                  string   `json:"startTime"`
+			Temperature                float64  `json:"temperature"`
+			TemperatureUnit            string   `json:"temperatureUnit"`
+			ProbabilityOfPrecipitation nwsValue `json:"probabilityOfPrecipitation"`
+			RelativeHumidity           nwsValue `json:"relativeHumidity"`
+			WindSpeed                  string   `json:"windSpeed"`
+			WindDirection              string   `json:"windDirection"`
+			Icon                       string   `json:"icon"`
+		} `json:"periods"`
+	} `json:"properties"`
+}
+
+// nwsValue is a quantitative value; Value is null when unknown.
+type nwsValue struct {
+	Value *float64 `json:"value"`
+}
+
+// FetchWeather resolves the forecast grid for the coordinate and retrieves
+// current weather and a daily forecast aggregated from the hourly forecast.
+func (c *NWSClient) FetchWeather(ctx context.Context, lat, lon float64, days int, imperial bool) (*WeatherData, error) {
+	var points nwsPointsResponse
+	url := fmt.Sprintf("%s/points/%.4f,%.4f", c.BaseURL, lat, lon)
+	if err := getJSON(ctx, c.HTTPClient, url, "NWS", &points); err != nil {
+		return nil, fmt.Errorf("%w (the NWS only covers the United States)", err)
+	}
+	if points.Properties.ForecastHourly == "" {
+		return nil, fmt.Errorf("NWS returned no forecast for this location")
+	}
+
+	var forecast nwsForecastResponse
+	if err := getJSON(ctx, c.HTTPClient, points.Properties.ForecastHourly, "NWS", &forecast); err != nil {
+		return nil, err
+	}
+
+	periods := forecast.Properties.Periods
+	if len(periods) == 0 {
+		return nil, fmt.Errorf("NWS returned no forecast periods")
+	}
+
+	var current CurrentWeather
+	var daily []DailyForecast
+	for i, p := range periods {
+		temp := p.Temperature
+		if p.TemperatureUnit == "C" {
+			temp = celsius(temp, imperial)
+		} else {
+			temp = fahrenheit(temp, imperial)
+		}
+		code := nwsWeatherCode(p.Icon)
+
+		if i == 0 {
+			current = CurrentWeather{
+				Temperature:   temp,
+				WindSpeed:     nwsWindSpeed(p.WindSpeed, imperial),
+				WindDirection: nwsWindDirection(p.WindDirection),
+				WeatherCode:   code,
+				Time:          trimSeconds(p.StartTime),
+			}
+			if p.RelativeHumidity.Value != nil {
+				current.Humidity = int(*p.RelativeHumidity.Value + 0.5)
+			}
+		}
+
+		// startTime carries the local offset, so its date is the local date.
+		if len(p.StartTime) < 10 {
+			continue
+		}
+		date := p.StartTime[:10]
+		if len(daily) == 0 || daily[len(daily)-1].Date != date {
+			if len(daily) == days {
+				break
+			}
+			daily = append(daily, DailyForecast{Date: date, TemperatureMax: temp, TemperatureMin: temp})
+		}
+		day := &daily[len(daily)-1]
+		if temp > day.TemperatureMax {
+			day.TemperatureMax = temp
+		}
+		if temp < day.TemperatureMin {
+			day.TemperatureMin = temp
+		}
+		if moreSevere(code, day.WeatherCode) {
+			day.WeatherCode = code
+		}
+		if v := p.ProbabilityOfPrecipitation.Value; v != nil && int(*v) > day.PrecipitationProbability {
+			day.PrecipitationProbability = int(*v)
+		}
+	}
+
+	return &WeatherData{
+		Current:  current,
+		Daily:    daily,
+		Timezone: points.Properties.TimeZone,
+	}, nil
+}
+
+// nwsWindSpeed parses a wind speed such as "10 mph" or "5 to 10 mph", using the
+// upper bound of a range, and converts it to the requested unit system.
+func nwsWindSpeed(s string, imperial bool) float64 {
+	fields := strings.Fields(s)
+	var mph float64
+	for _, f := range fields {
+		if v, err := strconv.ParseFloat(f, 64); err == nil {
+			mph = v
+		}
+	}
+	if imperial {
+		return mph
+	}
+	return mph * kmhPerMph
+}
+
+// nwsCardinals are the 16 compass points in the order used by units.WindCardinal.
+var nwsCardinals = []string{"N", "NNE", "NE", "ENE", "E", "ESE", "SE", "SSE", "S", "SSW", "SW", "WSW", "W", "WNW", "NW", "NNW"}
+
+// nwsWindDirection converts a compass point such as "NW" to degrees (0 if unknown).
+func nwsWindDirection(s string) int {
+	for i, c := range nwsCardinals {
+		if c == s {
+			return int(float64(i) * 22.5)
+		}
+	}
+	return 0
+}
+
+// nwsIcons maps NWS icon condition codes to the closest WMO weather code.
+var nwsIcons = map[string]int{
+	"skc":             0,
+	"few":             1,
+	"sct":             2,
+	"bkn":             3,
+	"ovc":             3,
+	"wind_skc":        0,
+	"wind_few":        1,
+	"wind_sct":        2,
+	"wind_bkn":        3,
+	"wind_ovc":        3,
+	"hot":             0,
+	"cold":            0,
+	"haze":            45,
+	"smoke":           45,
+	"dust":            45,
+	"fog":             45,
+	"rain":            63,
+	"rain_showers":    81,
+	"rain_showers_hi": 80,
+	"rain_snow":       73,
+	"rain_sleet":      66,
+	"rain_fzra":       66,
+	"snow_sleet":      73,
+	"snow_fzra":       67,
+	"fzra":            67,
+	"sleet":           66,
+	"snow":            73,
+	"blizzard":        75,
+	"tsra":            95,
+	"tsra_sct":        95,
+	"tsra_hi":         95,
+	"tornado":         95,
+	"hurricane":       95,
+	"tropical_storm":  95,
+}
+
+// nwsWeatherCode maps an NWS icon URL such as
+// ".../icons/land/day/rain_showers,40/tsra,60?size=small" to a WMO code. Icons
+// can combine two conditions; the more severe one wins.
+func nwsWeatherCode(icon string) int {
+	if i := strings.IndexByte(icon, '?'); i >= 0 {
+		icon = icon[:i]
+	}
+	i := strings.Index(icon, "/icons/land/")
+	if i < 0 {
+		return 0
+	}
+	parts := strings.Split(icon[i+len("/icons/land/"):], "/")
+
+	code := 0
+	// The first part is "day" or "night".
+	for _, part := range parts[1:] {
+		if j := strings.IndexByte(part, ','); j >= 0 {
+			part = part[:j]
+		}
+		if c := nwsIcons[part]; moreSevere(c, code) {
+			code = c
+		}
+	}
+	return code
+}
@@ -1,93 +1,207 @@
 package weather
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 )
 
 const baseURL = "https://api.open-meteo.com/v1/forecast"
 
+// userAgent identifies the client; MET Norway and the NWS reject requests without one.
+const userAgent = "goweather"
+
 // Client fetches weather data from the Open-Meteo API.
 type Client struct {
 	HTTPClient *http.Client
 	BaseURL    string
+	Elevation  *float64 // forecast elevation in meters; nil uses the terrain elevation
 }
 
 // NewClient creates a weather API client with default settings.
 func NewClient() *Client {
 	return &Client{
-		HTTPClient: &http.Client{Timeout: 10 * time.Second},
+		HTTPClient: &http.Client{},
 		BaseURL:    baseURL,
 	}
 }
 
+// SetElevation makes forecasts apply to the given elevation in meters.
+func (c *Client) SetElevation(meters float64) {
+	c.Elevation = &meters
+}
+
+// providesDetails marks that current conditions include the details shown
+// by --details.
+func (c *Client) providesDetails() {}
+
+// elevationParam returns the Open-Meteo elevation query parameter, or nothing
+// when elevation is nil.
+func elevationParam(elevation *float64) string {
+	if elevation == nil {
+		return ""
+	}
+	return fmt.Sprintf("&elevation=%.0f", *elevation)
+}
+
 // apiResponse mirrors the Open-Meteo JSON structure.
 type apiResponse struct {
-	Latitude  float64 `json:"latitude"`
-	Longitude float64 `json:"longitude"`
-	Timezone  string  `json:"timezone"`
-	Current   struct {
-		Time               string  `json:"time"`
-		Temperature2m      float64 `json:"temperature_2m"`
-		RelativeHumidity2m int     `json:"relative_humidity_2m"`
-		ApparentTemp       float64 `json:"apparent_temperature"`
-		WindSpeed10m       float64 `json:"wind_speed_10m"`
-		WindDirection10m   int     `json:"wind_direction_10m"`
-		WeatherCode        int     `json:"weather_code"`
+	Latitude     float64 `json:"latitude"`
+	Longitude    float64 `json:"longitude"`
+	Timezone     string  `json:"timezone"`
+	CurrentUnits struct {
+		Visibility    string `json:"visibility"`
+		FreezingLevel string `json:"freezing_level_height"`
+	} `json:"current_units"`
+	Current struct {
+		Time               string   `json:"time"`
+		Temperature2m      float64  `json:"temperature_2m"`
+		RelativeHumidity2m int      `json:"relative_humidity_2m"`
+		ApparentTemp       float64  `json:"apparent_temperature"`
+		WindSpeed10m       float64  `json:"wind_speed_10m"`
+		WindDirection10m   int      `json:"wind_direction_10m"`
+		WeatherCode        int      `json:"weather_code"`
+		UVIndex            float64  `json:"uv_index"`
+		SurfacePressure    float64  `json:"surface_pressure"`
+		DewPoint2m         float64  `json:"dew_point_2m"`
+		CloudCover         int      `json:"cloud_cover"`
+		Visibility         float64  `json:"visibility"`
+		WindGusts10m       float64  `json:"wind_gusts_10m"`
+		FreezingLevel      *float64 `json:"freezing_level_height"` // null where the model lacks it
 	} `json:"current"`
+	// Hourly surface pressure for the last 3 hours, used for the pressure trend
+	Hourly struct {
+		Time            []string   `json:"time"`
+		SurfacePressure []*float64 `json:"surface_pressure"`
+	} `json:"hourly"`
 	Daily struct {
-		Time           []string  `json:"time"`
-		TempMax        []float64 `json:"temperature_2m_max"`
-		TempMin        []float64 `json:"temperature_2m_min"`
-		WeatherCode    []int     `json:"weather_code"`
+		Time        []string  `json:"time"`
+		TempMax     []float64 `json:"temperature_2m_max"`
+		TempMin     []float64 `json:"temperature_2m_min"`
+		WeatherCode []int     `json:"weather_code"`
+		PrecipSum   []float64 `json:"precipitation_sum"`
+		PrecipProb  []int     `json:"precipitation_probability_max"`
+		PrecipHours []float64 `json:"precipitation_hours"`
+		Sunrise     []string  `json:"sunrise"`
+		Sunset      []string  `json:"sunset"`
+		Daylight    []float64 `json:"daylight_duration"`
+		Sunshine    []float64 `json:"sunshine_duration"`
+		UVIndexMax  []float64 `json:"uv_index_max"`
+		GustsMax    []float64 `json:"wind_gusts_10m_max"`
 	} `json:"daily"`
 }
 
-// FetchWeather retrieves current weather and daily forecast.
-func (c *Client) FetchWeather(lat, lon float64, days int, imperial bool) (*WeatherData, error) {
-	tempUnit := "celsius"
-	windUnit := "kmh"
+// modelsResponse mirrors an Open-Meteo response for several models. Each daily
+// variable is suffixed with the model name, e.g. "temperature_2m_max_icon_seamless",
+// so the daily block is decoded field by field.
+type modelsResponse struct {
+	Timezone string                     `json:"timezone"`
+	Daily    map[string]json.RawMessage `json:"daily"`
+}
+
+// modelField decodes the daily variable field for model into v. A request for
+// a single model returns unsuffixed names, which single allows as a fallback.
+// A missing variable leaves v unchanged.
+func (r *modelsResponse) modelField(field, model string, single bool, v interface{}) error {
+	raw, ok := r.Daily[field+"_"+model]
+	if !ok && single {
+		raw, ok = r.Daily[field]
+	}
+	if !ok {
+		return nil
+	}
+	if err := json.Unmarshal(raw, v); err != nil {
+		return fmt.Errorf("failed to parse %s for model %s: %w", field, model, err)
+	}
+	return nil
+}
+
+// hourlyResponse mirrors the hourly block of the Open-Meteo JSON structure.
+type hourlyResponse struct {
+	Timezone string `json:"timezone"`
+	Hourly   struct {
+		Time             []string  `json:"time"`
+		Temperature2m    []float64 `json:"temperature_2m"`
+		PrecipProb       []int     `json:"precipitation_probability"`
+		WeatherCode      []int     `json:"weather_code"`
+		WindSpeed10m     []float64 `json:"wind_speed_10m"`
+		WindDirection10m []int     `json:"wind_direction_10m"`
+	} `json:"hourly"`
+}
+
+// unitParams returns the Open-Meteo temperature, wind speed and precipitation unit names.
+func unitParams(imperial bool) (tempUnit, windUnit, precipUnit string) {
 	if imperial {
-		tempUnit = "fahrenheit"
-		windUnit = "mph"
+		return "fahrenheit", "mph", "inch"
 	}
+	return "celsius", "kmh", "mm"
+}
+
+// FetchWeather retrieves current weather and daily forecast.
+func (c *Client) FetchWeather(ctx context.Context, lat, lon float64, days int, imperial bool) (*WeatherData, error) {
+	return c.FetchWeatherWithPast(ctx, lat, lon, 0, days, imperial)
+}
+
+// FetchWeatherWithPast retrieves current weather and the daily forecast,
+// preceded by the given number of past days.
+func (c *Client) FetchWeatherWithPast(ctx context.Context, lat, lon float64, pastDays, days int, imperial bool) (*WeatherData, error) {
+	tempUnit, windUnit, precipUnit := unitParams(imperial)
 
 	url := fmt.Sprintf(
 		"%s?latitude=%.4f&longitude=%.4f"+
-			"&current=temperature_2m,relative_humidity_2m,apparent_temperature,wind_speed_10m,wind_direction_10m,weather_code"+
-			"&daily=temperature_2m_max,temperature_2m_min,weather_code"+
-			"&timezone=auto&forecast_days=%d"+
-			"&temperature_unit=%s&wind_speed_unit=%s",
-		c.BaseURL, lat, lon, days, tempUnit, windUnit,
+			"&current=temperature_2m,relative_humidity_2m,apparent_temperature,wind_speed_10m,wind_direction_10m,weather_code,uv_index"+
+			",surface_pressure,dew_point_2m,cloud_cover,visibility,wind_gusts_10m,freezing_level_height"+
+			"&hourly=surface_pressure&past_hours=3&forecast_hours=1"+
+			"&daily=temperature_2m_max,temperature_2m_min,weather_code,precipitation_sum,precipitation_probability_max,precipitation_hours"+
+			",sunrise,sunset,daylight_duration,sunshine_duration,uv_index_max,wind_gusts_10m_max"+
+			"&timezone=auto&past_days=%d&forecast_days=%d"+
+			"&temperature_unit=%s&wind_speed_unit=%s&precipitation_unit=%s%s",
+		c.BaseURL, lat, lon, pastDays, days, tempUnit, windUnit, precipUnit, elevationParam(c.Elevation),
 	)
 
-	resp, err := c.HTTPClient.Get(url)
-	if err != nil {
-		return nil, fmt.Errorf("weather API request failed: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("weather API returned status %d", resp.StatusCode)
-	}
-
 	var apiResp apiResponse
-	if err := json.NewDecoder(resp.Body).Decode(&apiResp); err != nil {
-		return nil, fmt.Errorf("failed to parse weather response: %w", err)
+	if err := getJSON(ctx, c.HTTPClient, url, "weather", &apiResp); err != nil {
+		return nil, err
 	}
 
 	current := CurrentWeather{
-		Temperature:         apiResp.Current.Temperature2m,
-		ApparentTemperature: apiResp.Current.ApparentTemp,
-		Humidity:            apiResp.Current.RelativeHumidity2m,
-		WindSpeed:           apiResp.Current.WindSpeed10m,
-		WindDirection:       apiResp.Current.WindDirection10m,
-		WeatherCode:         apiResp.Current.WeatherCode,
-		Time:                apiResp.Current.Time,
+		Temperature:            apiResp.Current.Temperature2m,
+		ApparentTemperature:    apiResp.Current.ApparentTemp,
+		HasApparentTemperature: true,
+		Humidity:               apiResp.Current.RelativeHumidity2m,
+		WindSpeed:              apiResp.Current.WindSpeed10m,
+		WindDirection:          apiResp.Current.WindDirection10m,
+		WeatherCode:            apiResp.Current.WeatherCode,
+		UVIndex:                apiResp.Current.UVIndex,
+		Time:                   apiResp.Current.Time,
+		Pressure:               apiResp.Current.SurfacePressure,
+		DewPoint:               apiResp.Current.DewPoint2m,
+		CloudCover:             apiResp.Current.CloudCover,
+		Visibility:             apiResp.Current.Visibility,
+		WindGusts:              apiResp.Current.WindGusts10m,
+		HasDetails:             true,
+	}
+	// Normalize visibility to meters in case the API reports it in feet
+	if apiResp.CurrentUnits.Visibility == "ft" {
+		current.Visibility *= metersPerFoot
+	}
+	if fl := apiResp.Current.FreezingLevel; fl != nil {
+		current.FreezingLevel, current.HasFreezingLevel = *fl, true
+		if apiResp.CurrentUnits.FreezingLevel == "ft" {
+			current.FreezingLevel *= metersPerFoot
+		}
 	}
+	current.PressureTrend, current.HasPressureTrend = pressureTrend(
+		apiResp.Current.Time, apiResp.Current.SurfacePressure,
+		apiResp.Hourly.Time, apiResp.Hourly.SurfacePressure)
 
+	n := len(apiResp.Daily.Time)
+	if len(apiResp.Daily.TempMax) < n || len(apiResp.Daily.TempMin) < n || len(apiResp.Daily.WeatherCode) < n {
+		return nil, fmt.Errorf("weather API returned incomplete daily data")
+	}
 	daily := make([]DailyForecast, len(apiResp.Daily.Time))
 	for i := range apiResp.Daily.Time {
 		daily[i] = DailyForecast{
@@ -96,11 +210,199 @@ func (c *Client) FetchWeather(lat, lon float64, days int, imperial bool) (*Weath
 			TemperatureMin: apiResp.Daily.TempMin[i],
 			WeatherCode:    apiResp.Daily.WeatherCode[i],
 		}
+		// Optional fields may be missing for some models; leave them zero.
+		if i < len(apiResp.Daily.PrecipSum) {
+			daily[i].PrecipitationSum = apiResp.Daily.PrecipSum[i]
+		}
+		if i < len(apiResp.Daily.PrecipProb) {
+			daily[i].PrecipitationProbability = apiResp.Daily.PrecipProb[i]
+		}
+		if i < len(apiResp.Daily.PrecipHours) {
+			daily[i].PrecipitationHours = apiResp.Daily.PrecipHours[i]
+		}
+		if i < len(apiResp.Daily.Sunrise) {
+			daily[i].Sunrise = apiResp.Daily.Sunrise[i]
+		}
+		if i < len(apiResp.Daily.Sunset) {
+			daily[i].Sunset = apiResp.Daily.Sunset[i]
+		}
+		if i < len(apiResp.Daily.Daylight) {
+			daily[i].DaylightDuration = apiResp.Daily.Daylight[i]
+		}
+		if i < len(apiResp.Daily.Sunshine) {
+			daily[i].SunshineDuration = apiResp.Daily.Sunshine[i]
+		}
+		if i < len(apiResp.Daily.UVIndexMax) {
+			daily[i].UVIndexMax = apiResp.Daily.UVIndexMax[i]
+		}
+		if i < len(apiResp.Daily.GustsMax) {
+			daily[i].WindGustsMax = apiResp.Daily.GustsMax[i]
+		}
+	}
+
+	if pastDays > len(daily) {
+		pastDays = len(daily)
 	}
 
 	return &WeatherData{
 		Current:  current,
 		Daily:    daily,
+		PastDays: pastDays,
 		Timezone: apiResp.Timezone,
 	}, nil
 }
+
+// pressureTrend returns the change of the current pressure against the hourly
+// value three hours before the current hour, if that value is available.
+func pressureTrend(now string, pressure float64, times []string, values []*float64) (float64, bool) {
+	t, err := time.Parse("2006-01-02T15:04", now)
+	if err != nil {
+		return 0, false
+	}
+	past := t.Truncate(time.Hour).Add(-3 * time.Hour).Format("2006-01-02T15:04")
+	for i, ts := range times {
+		if ts == past && i < len(values) && values[i] != nil {
+			return pressure - *values[i], true
+		}
+	}
+	return 0, false
+}
+
+// FetchHourly retrieves the hourly forecast for the next given number of hours,
+// starting with the current hour.
+func (c *Client) FetchHourly(ctx context.Context, lat, lon float64, hours int, imperial bool) ([]HourlyForecast, error) {
+	tempUnit, windUnit, _ := unitParams(imperial)
+
+	url := fmt.Sprintf(
+		"%s?latitude=%.4f&longitude=%.4f"+
+			"&hourly=temperature_2m,precipitation_probability,weather_code,wind_speed_10m,wind_direction_10m"+
+			"&timezone=auto&forecast_hours=%d"+
+			"&temperature_unit=%s&wind_speed_unit=%s%s",
+		c.BaseURL, lat, lon, hours, tempUnit, windUnit, elevationParam(c.Elevation),
+	)
+
+	var apiResp hourlyResponse
+	if err := getJSON(ctx, c.HTTPClient, url, "weather", &apiResp); err != nil {
+		return nil, err
+	}
+
+	h := apiResp.Hourly
+	if len(h.Temperature2m) < len(h.Time) {
+		return nil, fmt.Errorf("weather API returned incomplete hourly data")
+	}
+	hourly := make([]HourlyForecast, len(h.Time))
+	for i := range h.Time {
+		hourly[i] = HourlyForecast{
+			Time:        h.Time[i],
+			Temperature: h.Temperature2m[i],
+		}
+		// Fields missing for some hours are left zero
+		if i < len(h.PrecipProb) {
+			hourly[i].PrecipitationProbability = h.PrecipProb[i]
+		}
+		if i < len(h.WeatherCode) {
+			hourly[i].WeatherCode = h.WeatherCode[i]
+		}
+		if i < len(h.WindSpeed10m) {
+			hourly[i].WindSpeed = h.WindSpeed10m[i]
+		}
+		if i < len(h.WindDirection10m) {
+			hourly[i].WindDirection = h.WindDirection10m[i]
+		}
+	}
+
+	return hourly, nil
+}
+
+// FetchModels retrieves the daily forecast of each of the given Open-Meteo
+// models (see ResolveModels) in a single request.
+func (c *Client) FetchModels(ctx context.Context, lat, lon float64, days int, imperial bool, models []string) ([]ModelForecast, error) {
+	tempUnit, windUnit, precipUnit := unitParams(imperial)
+
+	url := fmt.Sprintf(
+		"%s?latitude=%.4f&longitude=%.4f"+
+			"&daily=temperature_2m_max,temperature_2m_min,weather_code,precipitation_sum"+
+			"&models=%s&timezone=auto&forecast_days=%d"+
+			"&temperature_unit=%s&wind_speed_unit=%s&precipitation_unit=%s%s",
+		c.BaseURL, lat, lon, strings.Join(models, ","), days, tempUnit, windUnit, precipUnit, elevationParam(c.Elevation),
+	)
+
+	var apiResp modelsResponse
+	if err := getJSON(ctx, c.HTTPClient, url, "weather", &apiResp); err != nil {
+		return nil, err
+	}
+
+	var dates []string
+	if raw, ok := apiResp.Daily["time"]; ok {
+		if err := json.Unmarshal(raw, &dates); err != nil {
+			return nil, fmt.Errorf("failed to parse weather response: %w", err)
+		}
+	}
+
+	single := len(models) == 1
+	result := make([]ModelForecast, len(models))
+	for m, model := range models {
+		// Values are null beyond a model's forecast horizon or outside its domain.
+		var tempMax, tempMin, precip []*float64
+		var codes []*int
+		for _, f := range []struct {
+			name string
+			v    interface{}
+		}{
+			{"temperature_2m_max", &tempMax},
+			{"temperature_2m_min", &tempMin},
+			{"weather_code", &codes},
+			{"precipitation_sum", &precip},
+		} {
+			if err := apiResp.modelField(f.name, model, single, f.v); err != nil {
+				return nil, err
+			}
+		}
+
+		result[m].Model = model
+		for i, date := range dates {
+			if i >= len(tempMax) || i >= len(tempMin) || tempMax[i] == nil || tempMin[i] == nil {
+				continue
+			}
+			d := DailyForecast{
+				Date:           date,
+				TemperatureMax: *tempMax[i],
+				TemperatureMin: *tempMin[i],
+			}
+			if i < len(codes) && codes[i] != nil {
+				d.WeatherCode = *codes[i]
+			}
+			if i < len(precip) && precip[i] != nil {
+				d.PrecipitationSum = *precip[i]
+			}
+			result[m].Daily = append(result[m].Daily, d)
+		}
+	}
+
+	return result, nil
+}
+
+// getJSON performs a GET request and decodes the JSON response into v.
+// The api name is used to give errors context, e.g. "weather API returned status 500".
+func getJSON(ctx context.Context, client *http.Client, url, api string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("%s API request failed: %w", api, err)
+	}
+	req.Header.Set("User-Agent", userAgent)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s API request failed: %w", api, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s API returned status %d", api, resp.StatusCode)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("failed to parse %s response: %w", api, err)
+	}
+	return nil
+}
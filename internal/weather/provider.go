@@ -0,0 +1,152 @@
+package weather
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Provider is a weather backend that returns current conditions and a daily forecast.
+// Values are converted to the requested unit system and weather conditions are
+// expressed as WMO codes, whatever the backend uses natively.
+type Provider interface {
+	FetchWeather(ctx context.Context, lat, lon float64, days int, imperial bool) (*WeatherData, error)
+}
+
+// HourlyProvider is implemented by providers that also offer an hourly forecast.
+type HourlyProvider interface {
+	FetchHourly(ctx context.Context, lat, lon float64, hours int, imperial bool) ([]HourlyForecast, error)
+}
+
+// PastProvider is implemented by providers that can include recent past days
+// at the start of the daily forecast.
+type PastProvider interface {
+	FetchWeatherWithPast(ctx context.Context, lat, lon float64, pastDays, days int, imperial bool) (*WeatherData, error)
+}
+
+// DetailsProvider is implemented by providers whose current conditions include
+// pressure with its trend, dew point, cloud cover, visibility and gusts.
+type DetailsProvider interface {
+	providesDetails()
+}
+
+// NowcastProvider is implemented by providers that offer precipitation for the
+// next two hours in 15-minute steps.
+type NowcastProvider interface {
+	FetchNowcast(ctx context.Context, lat, lon float64, imperial bool) (*Nowcast, error)
+}
+
+// SnowProvider is implemented by providers that offer new snow, snow depth and
+// the freezing level.
+type SnowProvider interface {
+	FetchSnow(ctx context.Context, lat, lon float64, days int) (*SnowData, error)
+}
+
+// ModelsProvider is implemented by providers that can compare the forecasts of
+// several weather models.
+type ModelsProvider interface {
+	FetchModels(ctx context.Context, lat, lon float64, days int, imperial bool, models []string) ([]ModelForecast, error)
+}
+
+// ElevationProvider is implemented by providers that can forecast for a given
+// elevation, such as a summit, instead of the model's terrain height.
+type ElevationProvider interface {
+	SetElevation(meters float64)
+}
+
+// ProviderNames lists the names accepted by NewProvider; the first is the default.
+var ProviderNames = []string{"open-meteo", "metno", "nws"}
+
+// NewProvider returns the provider with the given name, using default settings
+// and sending its requests through client.
+func NewProvider(name string, client *http.Client) (Provider, error) {
+	switch name {
+	case "", "open-meteo":
+		c := NewClient()
+		c.HTTPClient = client
+		return c, nil
+	case "metno":
+		c := NewMetNoClient()
+		c.HTTPClient = client
+		return c, nil
+	case "nws":
+		c := NewNWSClient()
+		c.HTTPClient = client
+		return c, nil
+	default:
+		return nil, fmt.Errorf("unknown provider %q (available: %s)", name, strings.Join(ProviderNames, ", "))
+	}
+}
+
+// Unit conversions for providers that only serve one unit system.
+const (
+	kmhPerMs  = 3.6
+	mphPerMs  = 2.23694
+	kmhPerMph = 1.609344
+	mmPerInch = 25.4
+
+	metersPerFoot = 0.3048
+)
+
+// celsius converts a °C value to the requested unit system.
+func celsius(c float64, imperial bool) float64 {
+	if imperial {
+		return c*9/5 + 32
+	}
+	return c
+}
+
+// fahrenheit converts a °F value to the requested unit system.
+func fahrenheit(f float64, imperial bool) float64 {
+	if imperial {
+		return f
+	}
+	return (f - 32) * 5 / 9
+}
+
+// millimeters converts a precipitation amount in mm to the requested unit system.
+func millimeters(mm float64, imperial bool) float64 {
+	if imperial {
+		return mm / mmPerInch
+	}
+	return mm
+}
+
+// trimSeconds converts an RFC 3339 timestamp to the YYYY-MM-DDTHH:MM form used
+// by Open-Meteo, keeping the clock time as given.
+func trimSeconds(ts string) string {
+	if len(ts) < 16 {
+		return ts
+	}
+	return ts[:16]
+}
+
+// wmoSeverity lists WMO weather codes from least to most severe. The codes
+// themselves are not ordered that way: slight rain showers (80) are numbered
+// above heavy snow (75) and heavy rain (65).
+var wmoSeverity = []int{
+	0, 1, 2, 3, // clear to overcast
+	45, 48, // fog
+	51, 53, 55, // drizzle
+	80, 61, 81, 63, // rain and showers
+	56, 57, // freezing drizzle
+	77, 71, 85, 82, 65, 73, 86, // snow, heavy rain and showers
+	66, 75, 67, // freezing rain, heavy snow
+	95, 96, 99, // thunderstorms
+}
+
+// moreSevere reports whether WMO code a is more severe than b, for picking
+// the condition that summarizes a day. Unknown codes rank lowest.
+func moreSevere(a, b int) bool {
+	return severityRank(a) > severityRank(b)
+}
+
+func severityRank(code int) int {
+	for i, c := range wmoSeverity {
+		if c == code {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,54 @@
+package weather
+
+import (
+	"context"
+	"fmt"
+)
+
+// radiationResponse mirrors the hourly radiation block of the Open-Meteo JSON structure.
+type radiationResponse struct {
+	Timezone  string `json:"timezone"`
+	UTCOffset int    `json:"utc_offset_seconds"`
+	Hourly    struct {
+		Time      []string   `json:"time"`
+		Shortwave []*float64 `json:"shortwave_radiation"`
+		Direct    []*float64 `json:"direct_radiation"`
+		Diffuse   []*float64 `json:"diffuse_radiation"`
+	} `json:"hourly"`
+}
+
+// FetchRadiation retrieves the hourly global, direct and diffuse radiation
+// for the given number of days, starting today. Hours without data are skipped.
+func (c *Client) FetchRadiation(ctx context.Context, lat, lon float64, days int) (*Radiation, error) {
+	url := fmt.Sprintf(
+		"%s?latitude=%.4f&longitude=%.4f"+
+			"&hourly=shortwave_radiation,direct_radiation,diffuse_radiation"+
+			"&timezone=auto&forecast_days=%d%s",
+		c.BaseURL, lat, lon, days, elevationParam(c.Elevation),
+	)
+
+	var apiResp radiationResponse
+	if err := getJSON(ctx, c.HTTPClient, url, "weather", &apiResp); err != nil {
+		return nil, err
+	}
+
+	h := apiResp.Hourly
+	radiation := &Radiation{Timezone: apiResp.Timezone, UTCOffset: apiResp.UTCOffset}
+	for i, ts := range h.Time {
+		if i >= len(h.Shortwave) || i >= len(h.Direct) || i >= len(h.Diffuse) ||
+			h.Shortwave[i] == nil || h.Direct[i] == nil || h.Diffuse[i] == nil {
+			continue
+		}
+		radiation.Hourly = append(radiation.Hourly, RadiationHour{
+			Time:      ts,
+			Shortwave: *h.Shortwave[i],
+			Direct:    *h.Direct[i],
+			Diffuse:   *h.Diffuse[i],
+		})
+	}
+
+	if len(radiation.Hourly) == 0 {
+		return nil, fmt.Errorf("no radiation data for this location")
+	}
+	return radiation, nil
+}
@@ -0,0 +1,83 @@
+package weather
+
+import (
+	"context"
+	"fmt"
+)
+
+// snowResponse mirrors the snow variables of the Open-Meteo JSON structure.
+// Snow depth and freezing level are null for models that do not provide them.
+type snowResponse struct {
+	Current struct {
+		Time          string   `json:"time"`
+		SnowDepth     *float64 `json:"snow_depth"`
+		FreezingLevel *float64 `json:"freezing_level_height"`
+	} `json:"current"`
+	Hourly struct {
+		Time          []string   `json:"time"`
+		FreezingLevel []*float64 `json:"freezing_level_height"`
+	} `json:"hourly"`
+	Daily struct {
+		Time        []string  `json:"time"`
+		SnowfallSum []float64 `json:"snowfall_sum"`
+	} `json:"daily"`
+}
+
+// FetchSnow retrieves the current snow depth and freezing level and, per day,
+// the new snow and the range of the hourly freezing level. Values are always
+// requested in metric units (cm of snow, meters).
+func (c *Client) FetchSnow(ctx context.Context, lat, lon float64, days int) (*SnowData, error) {
+	url := fmt.Sprintf(
+		"%s?latitude=%.4f&longitude=%.4f"+
+			"&current=snow_depth,freezing_level_height"+
+			"&hourly=freezing_level_height&daily=snowfall_sum"+
+			"&timezone=auto&forecast_days=%d%s",
+		c.BaseURL, lat, lon, days, elevationParam(c.Elevation),
+	)
+
+	var apiResp snowResponse
+	if err := getJSON(ctx, c.HTTPClient, url, "weather", &apiResp); err != nil {
+		return nil, err
+	}
+
+	snow := &SnowData{}
+	// Snow depth is reported in meters
+	if d := apiResp.Current.SnowDepth; d != nil {
+		snow.Depth, snow.HasDepth = *d*100, true
+	}
+	if fl := apiResp.Current.FreezingLevel; fl != nil {
+		snow.FreezingLevel, snow.HasFreezingLevel = *fl, true
+	}
+
+	snow.Daily = make([]DailySnow, len(apiResp.Daily.Time))
+	index := make(map[string]int, len(apiResp.Daily.Time))
+	for i, date := range apiResp.Daily.Time {
+		snow.Daily[i] = DailySnow{Date: date}
+		if i < len(apiResp.Daily.SnowfallSum) {
+			snow.Daily[i].Snowfall = apiResp.Daily.SnowfallSum[i]
+		}
+		index[date] = i
+	}
+
+	h := apiResp.Hourly
+	for i, ts := range h.Time {
+		if i >= len(h.FreezingLevel) || h.FreezingLevel[i] == nil || len(ts) < 10 {
+			continue
+		}
+		day, ok := index[ts[:10]]
+		if !ok {
+			continue
+		}
+		d := &snow.Daily[day]
+		level := *h.FreezingLevel[i]
+		if !d.HasFreezingLevel || level < d.FreezingLevelMin {
+			d.FreezingLevelMin = level
+		}
+		if !d.HasFreezingLevel || level > d.FreezingLevelMax {
+			d.FreezingLevelMax = level
+		}
+		d.HasFreezingLevel = true
+	}
+
+	return snow, nil
+}
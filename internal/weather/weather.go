@@ -2,26 +2,235 @@ package weather
 
 // CurrentWeather holds current weather conditions from the API.
 type CurrentWeather struct {
-	Temperature        float64
-	ApparentTemperature float64
-	Humidity           int
-	WindSpeed          float64
-	WindDirection      int
-	WeatherCode        int
-	Time               string
+	Temperature            float64
+	ApparentTemperature    float64 // set when HasApparentTemperature
+	HasApparentTemperature bool
+	Humidity               int
+	WindSpeed              float64
+	WindDirection          int
+	WeatherCode            int
+	UVIndex                float64
+	Time                   string
+
+	// Atmospheric details, set when HasDetails
+	Pressure         float64 // surface pressure in hPa
+	PressureTrend    float64 // change over the last 3 hours in hPa, set when HasPressureTrend
+	HasPressureTrend bool
+	DewPoint         float64
+	CloudCover       int     // percent
+	Visibility       float64 // meters
+	WindGusts        float64
+	HasDetails       bool
+
+	FreezingLevel    float64 // height of the 0°C isotherm in meters, set when HasFreezingLevel
+	HasFreezingLevel bool
 }
 
 // DailyForecast holds one day's forecast data.
 type DailyForecast struct {
+	Date                     string
+	TemperatureMax           float64
+	TemperatureMin           float64
+	WeatherCode              int
+	PrecipitationSum         float64 // mm or inches, depending on request
+	PrecipitationProbability int     // maximum probability over the day (0-100)
+	PrecipitationHours       float64
+	Sunrise                  string  // local time, YYYY-MM-DDTHH:MM
+	Sunset                   string  // local time, YYYY-MM-DDTHH:MM
+	DaylightDuration         float64 // seconds
+	SunshineDuration         float64 // seconds
+	UVIndexMax               float64
+	WindGustsMax             float64 // km/h or mph, depending on request
+	WindSpeedMean            float64 // km/h or mph, depending on request
+	RelativeHumidityMax      float64 // percent
+	RelativeHumidityMin      float64 // percent
+	ShortwaveRadiationSum    float64 // MJ/m²
+	NormalMax                float64 // climatological normal, set when HasNormal
+	NormalMin                float64
+	HasNormal                bool
+}
+
+// Percentiles summarizes the spread of a value across ensemble members.
+type Percentiles struct {
+	P10 float64
+	P50 float64
+	P90 float64
+}
+
+// DailyEnsemble holds one day's ensemble forecast as percentiles across members.
+type DailyEnsemble struct {
 	Date           string
-	TemperatureMax float64
-	TemperatureMin float64
-	WeatherCode    int
+	TemperatureMax Percentiles
+	TemperatureMin Percentiles
+	Precipitation  Percentiles
+	Members        int
+}
+
+// HourlyForecast holds one hour's forecast data.
+type HourlyForecast struct {
+	Time                     string
+	Temperature              float64
+	PrecipitationProbability int
+	WeatherCode              int
+	WindSpeed                float64
+	WindDirection            int
+}
+
+// AirQuality holds current air pollutant concentrations (µg/m³) and AQI values.
+type AirQuality struct {
+	PM25            float64
+	PM10            float64
+	Ozone           float64
+	NitrogenDioxide float64
+	EuropeanAQI     int
+	USAQI           int
+	Time            string
+}
+
+// PollenTypes lists the plants covered by the pollen forecast, in the order
+// used by DailyPollen.Levels.
+var PollenTypes = [6]string{"alder", "birch", "grass", "mugwort", "olive", "ragweed"}
+
+// DailyPollen holds one day's maximum pollen concentrations (grains/m³),
+// indexed like PollenTypes.
+type DailyPollen struct {
+	Date   string
+	Levels [6]float64
+}
+
+// PollenForecast holds the daily pollen forecast. Available is false when
+// the location lies outside the region covered by the pollen model.
+type PollenForecast struct {
+	Available bool
+	Daily     []DailyPollen
+}
+
+// NowcastStep holds the precipitation of one 15-minute interval.
+type NowcastStep struct {
+	Time          string  // local end of the interval, YYYY-MM-DDTHH:MM
+	Precipitation float64 // sum over the preceding 15 minutes
+}
+
+// Nowcast holds the short-term precipitation forecast in 15-minute steps.
+type Nowcast struct {
+	Time  string // local time of the forecast, YYYY-MM-DDTHH:MM
+	Steps []NowcastStep
+}
+
+// MarineConditions holds current sea state. Heights are in meters,
+// periods in seconds and the sea surface temperature in °C.
+type MarineConditions struct {
+	WaveHeight            float64
+	WaveDirection         int
+	WavePeriod            float64
+	SwellWaveHeight       float64
+	SwellWaveDirection    int
+	SwellWavePeriod       float64
+	SeaSurfaceTemperature float64
+	Time                  string
+}
+
+// DailyMarine holds one day's marine forecast (meters, seconds).
+type DailyMarine struct {
+	Date                  string
+	WaveHeightMax         float64
+	WaveDirectionDominant int
+	WavePeriodMax         float64
+	SwellWaveHeightMax    float64
+}
+
+// MarineData bundles current sea state with the daily marine forecast.
+type MarineData struct {
+	Current MarineConditions
+	Daily   []DailyMarine
+}
+
+// DailySnow holds one day's snow forecast. Snowfall is in cm and the freezing
+// level range in meters.
+type DailySnow struct {
+	Date             string
+	Snowfall         float64
+	FreezingLevelMin float64 // set when HasFreezingLevel
+	FreezingLevelMax float64
+	HasFreezingLevel bool
+}
+
+// SnowData bundles current snow conditions with the daily snow forecast.
+// Depth is in cm and the freezing level in meters.
+type SnowData struct {
+	Depth            float64 // set when HasDepth
+	HasDepth         bool
+	FreezingLevel    float64 // set when HasFreezingLevel
+	HasFreezingLevel bool
+	Daily            []DailySnow
+}
+
+// DailyDischarge holds the river discharge of one day in m³/s.
+type DailyDischarge struct {
+	Date      string
+	Discharge float64
+}
+
+// SoilLevel holds the soil temperature (°C) at a depth in cm and the
+// volumetric water content (m³/m³) of the soil layer around it.
+type SoilLevel struct {
+	Depth       int
+	Temperature float64
+	Moisture    float64
+}
+
+// RadiationHour holds the mean solar radiation in W/m² over the hour ending
+// at Time. Shortwave is the global horizontal radiation, Direct its direct
+// (beam) part on the horizontal plane and Diffuse the diffuse part.
+type RadiationHour struct {
+	Time      string
+	Shortwave float64
+	Direct    float64
+	Diffuse   float64
+}
+
+// Radiation holds the hourly solar radiation forecast. Times are local to
+// Timezone, an IANA name; UTCOffset (seconds) is its current offset, for when
+// the zone is unknown to the system.
+type Radiation struct {
+	Timezone  string
+	UTCOffset int
+	Hourly    []RadiationHour
+}
+
+// Warning is a derived severe-weather warning for one kind of hazard.
+// Kind is one of "heat", "frost", "gust", "precip" or "thunderstorm";
+// Value is the most extreme forecast value in the data's units, reached on Date.
+type Warning struct {
+	Kind  string
+	Date  string
+	Value float64
 }
 
 // WeatherData bundles current conditions with the daily forecast.
+// Hourly, AirQuality, Pollen, Marine and Snow are only populated when requested.
 type WeatherData struct {
-	Current  CurrentWeather
-	Daily    []DailyForecast
-	Timezone string
+	Current    CurrentWeather
+	Daily      []DailyForecast // past days first, then today and the forecast
+	PastDays   int             // number of past days at the start of Daily
+	Hourly     []HourlyForecast
+	AirQuality *AirQuality
+	Pollen     *PollenForecast
+	Marine     *MarineData
+	Snow       *SnowData
+	Soil       []SoilLevel // current soil conditions from the surface down
+	Nowcast    *Nowcast
+	Warnings   []Warning
+	Timezone   string
+
+	Elevation    float64 // meters above sea level the forecast is for, set when HasElevation
+	HasElevation bool
+}
+
+// Forecast returns the daily entries from today on, skipping past days.
+func (w *WeatherData) Forecast() []DailyForecast {
+	if w.PastDays >= len(w.Daily) {
+		return nil
+	}
+	return w.Daily[w.PastDays:]
 }
@@ -1,10 +1,15 @@
 package weather
 
 import (
+	"context"
+	"errors"
+	"math"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestFetchWeather(t *testing.T) {
@@ -24,7 +29,7 @@ func TestFetchWeather(t *testing.T) {
 		BaseURL:    server.URL,
 	}
 
-	data, err := client.FetchWeather(52.52, 13.41, 5, false)
+	data, err := client.FetchWeather(context.Background(), 52.52, 13.41, 5, false)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -38,12 +43,158 @@ func TestFetchWeather(t *testing.T) {
 	if data.Current.WeatherCode != 3 {
 		t.Errorf("weather_code = %d, want 3", data.Current.WeatherCode)
 	}
+	if data.Current.UVIndex != 1.35 {
+		t.Errorf("uv_index = %f, want 1.35", data.Current.UVIndex)
+	}
+	if !data.Current.HasApparentTemperature {
+		t.Error("apparent temperature should be set")
+	}
 	if len(data.Daily) != 5 {
 		t.Errorf("daily count = %d, want 5", len(data.Daily))
 	}
 	if data.Daily[0].TemperatureMax != 6.2 {
 		t.Errorf("daily[0].max = %f, want 6.2", data.Daily[0].TemperatureMax)
 	}
+	if data.Daily[1].PrecipitationSum != 4.6 {
+		t.Errorf("daily[1].precipitation_sum = %f, want 4.6", data.Daily[1].PrecipitationSum)
+	}
+	if data.Daily[1].PrecipitationProbability != 90 {
+		t.Errorf("daily[1].precipitation_probability_max = %d, want 90", data.Daily[1].PrecipitationProbability)
+	}
+	if data.Daily[1].PrecipitationHours != 7 {
+		t.Errorf("daily[1].precipitation_hours = %f, want 7", data.Daily[1].PrecipitationHours)
+	}
+	if data.Daily[0].Sunrise != "2026-02-14T07:28" {
+		t.Errorf("daily[0].sunrise = %q, want %q", data.Daily[0].Sunrise, "2026-02-14T07:28")
+	}
+	if data.Daily[0].Sunset != "2026-02-14T17:21" {
+		t.Errorf("daily[0].sunset = %q, want %q", data.Daily[0].Sunset, "2026-02-14T17:21")
+	}
+	if data.Daily[0].DaylightDuration != 35580 {
+		t.Errorf("daily[0].daylight_duration = %f, want 35580", data.Daily[0].DaylightDuration)
+	}
+	if data.Daily[3].UVIndexMax != 2.6 {
+		t.Errorf("daily[3].uv_index_max = %f, want 2.6", data.Daily[3].UVIndexMax)
+	}
+	if data.Daily[1].WindGustsMax != 61.9 {
+		t.Errorf("daily[1].wind_gusts_10m_max = %f, want 61.9", data.Daily[1].WindGustsMax)
+	}
+	if !data.Current.HasDetails || data.Current.Pressure != 1012.6 || data.Current.DewPoint != 0.7 {
+		t.Errorf("details = %+v, want pressure 1012.6 and dew point 0.7", data.Current)
+	}
+	if data.Current.CloudCover != 92 || data.Current.Visibility != 24140 || data.Current.WindGusts != 31.7 {
+		t.Errorf("details = %+v, want clouds 92, visibility 24140, gusts 31.7", data.Current)
+	}
+	if !data.Current.HasPressureTrend || math.Abs(data.Current.PressureTrend-(-2.2)) > 1e-9 {
+		t.Errorf("pressure trend = %f (set %v), want -2.2", data.Current.PressureTrend, data.Current.HasPressureTrend)
+	}
+	if !data.Current.HasFreezingLevel || data.Current.FreezingLevel != 870 {
+		t.Errorf("freezing level = %f (set %v), want 870", data.Current.FreezingLevel, data.Current.HasFreezingLevel)
+	}
+}
+
+func TestFetchWeatherCanceled(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	client := &Client{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	_, err := client.FetchWeather(ctx, 52.52, 13.41, 5, false)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("error = %v, want context.Canceled", err)
+	}
+}
+
+func TestFetchWeatherElevation(t *testing.T) {
+	var requestURL string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestURL = r.URL.String()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"current": {"time": "2026-02-14T12:00", "freezing_level_height": null}}`))
+	}))
+	defer server.Close()
+
+	client := &Client{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+	}
+
+	data, err := client.FetchWeather(context.Background(), 46.0207, 7.7491, 5, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Contains(requestURL, "elevation=") {
+		t.Errorf("request URL %q should not set an elevation by default", requestURL)
+	}
+	if data.Current.HasFreezingLevel {
+		t.Error("a null freezing level should not be reported")
+	}
+
+	client.SetElevation(4478)
+	if _, err := client.FetchWeather(context.Background(), 46.0207, 7.7491, 5, false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(requestURL, "elevation=4478") {
+		t.Errorf("request URL %q should contain elevation=4478", requestURL)
+	}
+}
+
+func TestFetchElevation(t *testing.T) {
+	fixture, err := os.ReadFile("../../testdata/elevation_response.json")
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+
+	var requestURL string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestURL = r.URL.String()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(fixture)
+	}))
+	defer server.Close()
+
+	client := &ElevationClient{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+	}
+
+	elevation, err := client.FetchElevation(context.Background(), 46.0207, 7.7491)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(requestURL, "latitude=46.0207&longitude=7.7491") {
+		t.Errorf("request URL %q should contain the coordinates", requestURL)
+	}
+	if elevation != 1608 {
+		t.Errorf("elevation = %f, want 1608", elevation)
+	}
+}
+
+func TestPressureTrend(t *testing.T) {
+	p := func(v float64) *float64 { return &v }
+	times := []string{"2026-02-14T09:00", "2026-02-14T10:00", "2026-02-14T11:00", "2026-02-14T12:00"}
+
+	// 12:15 compares against 09:00
+	trend, ok := pressureTrend("2026-02-14T12:15", 1016, times, []*float64{p(1012), p(1013), p(1014), p(1015)})
+	if !ok || trend != 4 {
+		t.Errorf("pressureTrend = %f, %v, want 4, true", trend, ok)
+	}
+	if _, ok := pressureTrend("2026-02-14T12:15", 1016, times, []*float64{nil, p(1013), p(1014), p(1015)}); ok {
+		t.Error("pressureTrend should report no trend when the value 3h ago is missing")
+	}
+	if _, ok := pressureTrend("2026-02-14T16:00", 1016, times, []*float64{p(1012), p(1013), p(1014), p(1015)}); ok {
+		t.Error("pressureTrend should report no trend when 3h ago is not in the data")
+	}
 }
 
 func TestFetchWeatherImperial(t *testing.T) {
@@ -65,7 +216,7 @@ func TestFetchWeatherImperial(t *testing.T) {
 		BaseURL:    server.URL,
 	}
 
-	_, err = client.FetchWeather(52.52, 13.41, 5, true)
+	_, err = client.FetchWeather(context.Background(), 52.52, 13.41, 5, true)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -78,6 +229,1153 @@ func TestFetchWeatherImperial(t *testing.T) {
 	if got := requestURL; got == "" {
 		t.Error("request URL should contain temperature_unit=fahrenheit")
 	}
+	if !strings.Contains(requestURL, "precipitation_unit=inch") {
+		t.Errorf("request URL %q should contain precipitation_unit=inch", requestURL)
+	}
+}
+
+func TestFetchWeatherWithPast(t *testing.T) {
+	fixture, err := os.ReadFile("../../testdata/weather_response.json")
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+
+	var requestURL string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestURL = r.URL.String()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(fixture)
+	}))
+	defer server.Close()
+
+	client := &Client{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+	}
+
+	data, err := client.FetchWeatherWithPast(context.Background(), 52.52, 13.41, 2, 3, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !strings.Contains(requestURL, "past_days=2&forecast_days=3") {
+		t.Errorf("request URL %q should contain past_days=2&forecast_days=3", requestURL)
+	}
+	if data.PastDays != 2 {
+		t.Errorf("past days = %d, want 2", data.PastDays)
+	}
+	forecast := data.Forecast()
+	if len(forecast) != 3 || forecast[0].Date != data.Daily[2].Date {
+		t.Errorf("Forecast() should skip the 2 past days, got %d days starting %s", len(forecast), forecast[0].Date)
+	}
+}
+
+func TestFetchHourly(t *testing.T) {
+	fixture, err := os.ReadFile("../../testdata/hourly_response.json")
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+
+	var requestURL string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestURL = r.URL.String()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(fixture)
+	}))
+	defer server.Close()
+
+	client := &Client{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+	}
+
+	hourly, err := client.FetchHourly(context.Background(), 52.52, 13.41, 6, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !strings.Contains(requestURL, "forecast_hours=6") {
+		t.Errorf("request URL %q should contain forecast_hours=6", requestURL)
+	}
+	if len(hourly) != 6 {
+		t.Fatalf("hourly count = %d, want 6", len(hourly))
+	}
+	if hourly[0].Time != "2026-02-14T12:00" {
+		t.Errorf("hourly[0].time = %q, want %q", hourly[0].Time, "2026-02-14T12:00")
+	}
+	if hourly[4].PrecipitationProbability != 80 {
+		t.Errorf("hourly[4].precipitation_probability = %d, want 80", hourly[4].PrecipitationProbability)
+	}
+	if hourly[4].WeatherCode != 63 {
+		t.Errorf("hourly[4].weather_code = %d, want 63", hourly[4].WeatherCode)
+	}
+	if hourly[2].Temperature != 6.1 {
+		t.Errorf("hourly[2].temperature = %f, want 6.1", hourly[2].Temperature)
+	}
+}
+
+func TestFetchHourlyShortArrays(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		wantErr bool
+	}{
+		{"missing optional values", `{"hourly": {"time": ["2026-02-14T12:00", "2026-02-14T13:00"],
+			"temperature_2m": [5.2, 5.8], "precipitation_probability": [10], "weather_code": [3],
+			"wind_speed_10m": [], "wind_direction_10m": [270]}}`, false},
+		{"missing temperatures", `{"hourly": {"time": ["2026-02-14T12:00", "2026-02-14T13:00"],
+			"temperature_2m": [5.2]}}`, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			client := &Client{
+				HTTPClient: server.Client(),
+				BaseURL:    server.URL,
+			}
+
+			hourly, err := client.FetchHourly(context.Background(), 52.52, 13.41, 2, false)
+			if tt.wantErr {
+				if err == nil {
+					t.Error("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(hourly) != 2 || hourly[1].Temperature != 5.8 || hourly[1].WeatherCode != 0 {
+				t.Errorf("hourly = %+v, want 2 hours with the second one's missing fields zero", hourly)
+			}
+		})
+	}
+}
+
+func TestFetchNowcast(t *testing.T) {
+	fixture, err := os.ReadFile("../../testdata/nowcast_response.json")
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+
+	var requestURL string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestURL = r.URL.String()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(fixture)
+	}))
+	defer server.Close()
+
+	client := &Client{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+	}
+
+	nowcast, err := client.FetchNowcast(context.Background(), 52.52, 13.41, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !strings.Contains(requestURL, "minutely_15=precipitation") {
+		t.Errorf("request URL %q should ask for minutely_15 precipitation", requestURL)
+	}
+	if nowcast.Time != "2026-02-14T14:00" {
+		t.Errorf("time = %q, want %q", nowcast.Time, "2026-02-14T14:00")
+	}
+	// The last step is null and skipped
+	if len(nowcast.Steps) != 8 {
+		t.Fatalf("step count = %d, want 8", len(nowcast.Steps))
+	}
+	if nowcast.Steps[3].Time != "2026-02-14T14:45" || nowcast.Steps[3].Precipitation != 0.5 {
+		t.Errorf("steps[3] = %+v, want 0.5 at 14:45", nowcast.Steps[3])
+	}
+}
+
+func TestFetchRadiation(t *testing.T) {
+	fixture, err := os.ReadFile("../../testdata/radiation_response.json")
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+
+	var requestURL string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestURL = r.URL.String()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(fixture)
+	}))
+	defer server.Close()
+
+	client := &Client{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+	}
+
+	radiation, err := client.FetchRadiation(context.Background(), 52.52, 13.41, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !strings.Contains(requestURL, "hourly=shortwave_radiation,direct_radiation,diffuse_radiation") {
+		t.Errorf("request URL %q should ask for the radiation components", requestURL)
+	}
+	if radiation.Timezone != "Europe/Berlin" || radiation.UTCOffset != 3600 {
+		t.Errorf("time zone = %q %d, want Europe/Berlin 3600", radiation.Timezone, radiation.UTCOffset)
+	}
+	// The last hour is null and skipped
+	if len(radiation.Hourly) != 47 {
+		t.Fatalf("hourly count = %d, want 47", len(radiation.Hourly))
+	}
+	h := radiation.Hourly[13]
+	if h.Time != "2026-02-14T13:00" || h.Shortwave != 419.5 || h.Direct != 302 || h.Diffuse != 117.5 {
+		t.Errorf("hourly[13] = %+v, want 13:00 with 419.5/302/117.5 W/m²", h)
+	}
+}
+
+func TestFetchGarden(t *testing.T) {
+	fixture, err := os.ReadFile("../../testdata/garden_response.json")
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+
+	var requestURL string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestURL = r.URL.String()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(fixture)
+	}))
+	defer server.Close()
+
+	client := &Client{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+	}
+
+	data, err := client.FetchGarden(context.Background(), 52.52, 13.41, 3, 4)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !strings.Contains(requestURL, "past_days=3") || !strings.Contains(requestURL, "forecast_days=4") {
+		t.Errorf("request URL %q should ask for 3 past and 4 forecast days", requestURL)
+	}
+	if strings.Contains(requestURL, "temperature_unit") {
+		t.Errorf("request URL %q should ask for metric values", requestURL)
+	}
+	if data.PastDays != 3 || len(data.Daily) != 7 {
+		t.Fatalf("past days = %d, daily count = %d, want 3 and 7", data.PastDays, len(data.Daily))
+	}
+	if !data.HasElevation || data.Elevation != 38 {
+		t.Errorf("elevation = %f (set %v), want 38", data.Elevation, data.HasElevation)
+	}
+
+	d := data.Daily[5]
+	if d.TemperatureMax != 21.5 || d.TemperatureMin != 12.3 || d.PrecipitationSum != 6.8 {
+		t.Errorf("daily[5] temperature/precipitation = %+v", d)
+	}
+	if d.RelativeHumidityMax != 84 || d.RelativeHumidityMin != 63 {
+		t.Errorf("daily[5] humidity = %.0f-%.0f, want 63-84", d.RelativeHumidityMin, d.RelativeHumidityMax)
+	}
+	if d.WindSpeedMean != 10 || d.ShortwaveRadiationSum != 22.07 {
+		t.Errorf("daily[5] wind = %f, radiation = %f, want 10 and 22.07", d.WindSpeedMean, d.ShortwaveRadiationSum)
+	}
+
+	// The deepest level has no temperature and is skipped
+	if len(data.Soil) != 3 {
+		t.Fatalf("soil levels = %d, want 3", len(data.Soil))
+	}
+	if s := data.Soil[1]; s.Depth != 6 || s.Temperature != 21.8 || s.Moisture != 0.168 {
+		t.Errorf("soil[1] = %+v, want 6 cm, 21.8°C, 0.168", s)
+	}
+}
+
+func TestFetchGardenShortArrays(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"daily": {"time": ["2026-02-14", "2026-02-15"],
+			"temperature_2m_max": [8.1, 9.4], "temperature_2m_min": [1.2]}}`))
+	}))
+	defer server.Close()
+
+	client := &Client{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+	}
+
+	if _, err := client.FetchGarden(context.Background(), 52.52, 13.41, 1, 1); err == nil {
+		t.Error("expected error for missing temperatures, got nil")
+	}
+}
+
+func TestFetchAirQuality(t *testing.T) {
+	fixture, err := os.ReadFile("../../testdata/airquality_response.json")
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+
+	var requestURL string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestURL = r.URL.String()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(fixture)
+	}))
+	defer server.Close()
+
+	client := &AirQualityClient{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+	}
+
+	aq, err := client.FetchAirQuality(context.Background(), 52.52, 13.41)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !strings.Contains(requestURL, "european_aqi") {
+		t.Errorf("request URL %q should request european_aqi", requestURL)
+	}
+	if aq.PM25 != 11.4 {
+		t.Errorf("pm2_5 = %f, want 11.4", aq.PM25)
+	}
+	if aq.NitrogenDioxide != 21.3 {
+		t.Errorf("nitrogen_dioxide = %f, want 21.3", aq.NitrogenDioxide)
+	}
+	if aq.EuropeanAQI != 34 {
+		t.Errorf("european_aqi = %d, want 34", aq.EuropeanAQI)
+	}
+	if aq.USAQI != 47 {
+		t.Errorf("us_aqi = %d, want 47", aq.USAQI)
+	}
+}
+
+func TestFetchAirQualityServerError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client := &AirQualityClient{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+	}
+
+	if _, err := client.FetchAirQuality(context.Background(), 52.52, 13.41); err == nil {
+		t.Error("expected error for server error, got nil")
+	}
+}
+
+func TestFetchPollen(t *testing.T) {
+	fixture, err := os.ReadFile("../../testdata/pollen_response.json")
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(fixture)
+	}))
+	defer server.Close()
+
+	client := &AirQualityClient{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+	}
+
+	pollen, err := client.FetchPollen(context.Background(), 52.52, 13.41, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !pollen.Available {
+		t.Fatal("pollen should be available")
+	}
+	if len(pollen.Daily) != 2 {
+		t.Fatalf("daily count = %d, want 2", len(pollen.Daily))
+	}
+	if pollen.Daily[1].Date != "2026-04-15" {
+		t.Errorf("daily[1].date = %q, want %q", pollen.Daily[1].Date, "2026-04-15")
+	}
+	// Daily values are the maximum of the hourly values
+	if got := pollen.Daily[0].Levels[1]; got != 40 {
+		t.Errorf("daily[0] birch = %f, want 40", got)
+	}
+	if got := pollen.Daily[1].Levels[1]; got != 120 {
+		t.Errorf("daily[1] birch = %f, want 120", got)
+	}
+	if got := pollen.Daily[0].Levels[0]; got != 4.3 {
+		t.Errorf("daily[0] alder = %f, want 4.3", got)
+	}
+}
+
+func TestFetchPollenUnavailable(t *testing.T) {
+	fixture, err := os.ReadFile("../../testdata/pollen_unavailable_response.json")
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(fixture)
+	}))
+	defer server.Close()
+
+	client := &AirQualityClient{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+	}
+
+	pollen, err := client.FetchPollen(context.Background(), 40.71, -74.01, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if pollen.Available {
+		t.Error("pollen should not be available when all values are null")
+	}
+}
+
+func TestFetchSnow(t *testing.T) {
+	fixture, err := os.ReadFile("../../testdata/snow_response.json")
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+
+	var requestURL string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestURL = r.URL.String()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(fixture)
+	}))
+	defer server.Close()
+
+	client := &Client{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+	}
+
+	snow, err := client.FetchSnow(context.Background(), 46.0207, 7.7491, 3)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !strings.Contains(requestURL, "daily=snowfall_sum") || strings.Contains(requestURL, "precipitation_unit") {
+		t.Errorf("request URL %q should ask for metric snowfall", requestURL)
+	}
+	if !snow.HasDepth || math.Abs(snow.Depth-85) > 1e-9 {
+		t.Errorf("snow depth = %f (set %v), want 85 cm", snow.Depth, snow.HasDepth)
+	}
+	if !snow.HasFreezingLevel || snow.FreezingLevel != 1570 {
+		t.Errorf("freezing level = %f (set %v), want 1570", snow.FreezingLevel, snow.HasFreezingLevel)
+	}
+	if len(snow.Daily) != 3 {
+		t.Fatalf("daily count = %d, want 3", len(snow.Daily))
+	}
+	if snow.Daily[1].Snowfall != 18.2 {
+		t.Errorf("daily[1].snowfall = %f, want 18.2", snow.Daily[1].Snowfall)
+	}
+	// Hourly freezing levels are reduced to a daily range, skipping nulls
+	for i, want := range [][2]float64{{1200, 1600}, {900, 1300}, {1500, 1900}} {
+		d := snow.Daily[i]
+		if !d.HasFreezingLevel || d.FreezingLevelMin != want[0] || d.FreezingLevelMax != want[1] {
+			t.Errorf("daily[%d] freezing level = %.0f-%.0f (set %v), want %.0f-%.0f",
+				i, d.FreezingLevelMin, d.FreezingLevelMax, d.HasFreezingLevel, want[0], want[1])
+		}
+	}
+}
+
+func TestFetchMarine(t *testing.T) {
+	fixture, err := os.ReadFile("../../testdata/marine_response.json")
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(fixture)
+	}))
+	defer server.Close()
+
+	client := &MarineClient{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+	}
+
+	marine, err := client.FetchMarine(context.Background(), 54.5, 10.5, 3)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if marine.Current.WaveHeight != 0.84 {
+		t.Errorf("wave_height = %f, want 0.84", marine.Current.WaveHeight)
+	}
+	if marine.Current.SwellWaveDirection != 275 {
+		t.Errorf("swell_wave_direction = %d, want 275", marine.Current.SwellWaveDirection)
+	}
+	if marine.Current.SeaSurfaceTemperature != 18.3 {
+		t.Errorf("sea_surface_temperature = %f, want 18.3", marine.Current.SeaSurfaceTemperature)
+	}
+	if len(marine.Daily) != 3 {
+		t.Fatalf("daily count = %d, want 3", len(marine.Daily))
+	}
+	if marine.Daily[2].WaveHeightMax != 1.48 {
+		t.Errorf("daily[2].wave_height_max = %f, want 1.48", marine.Daily[2].WaveHeightMax)
+	}
+}
+
+func TestFetchMarineInland(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"current":{"time":"2026-07-11T10:00","wave_height":null},"daily":{"time":[]}}`))
+	}))
+	defer server.Close()
+
+	client := &MarineClient{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+	}
+
+	if _, err := client.FetchMarine(context.Background(), 48.14, 11.58, 3); err == nil {
+		t.Error("expected error for inland location, got nil")
+	}
+}
+
+func TestFetchMarineShortArrays(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"current":{"time":"2026-07-11T10:00","wave_height":0.4},` +
+			`"daily":{"time":["2026-07-11","2026-07-12"],"wave_height_max":[0.6],"wave_direction_dominant":[]}}`))
+	}))
+	defer server.Close()
+
+	client := &MarineClient{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+	}
+
+	marine, err := client.FetchMarine(context.Background(), 54.5, 10.5, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(marine.Daily) != 2 || marine.Daily[0].WaveHeightMax != 0.6 || marine.Daily[1].WaveHeightMax != 0 {
+		t.Errorf("daily = %+v, want 2 days with the second one's missing values zero", marine.Daily)
+	}
+}
+
+func TestFetchDischarge(t *testing.T) {
+	fixture, err := os.ReadFile("../../testdata/flood_response.json")
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+
+	var requestURL string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestURL = r.URL.String()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(fixture)
+	}))
+	defer server.Close()
+
+	client := &FloodClient{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+	}
+
+	days, err := client.FetchDischarge(context.Background(), 51.05, 13.74, 7)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(requestURL, "daily=river_discharge") || !strings.Contains(requestURL, "forecast_days=7") {
+		t.Errorf("request URL %q should ask for 7 days of river discharge", requestURL)
+	}
+	// The last day has no value and is skipped
+	if len(days) != 6 {
+		t.Fatalf("day count = %d, want 6", len(days))
+	}
+	if days[4].Date != "2026-02-18" || days[4].Discharge != 802.5 {
+		t.Errorf("days[4] = %+v, want 802.5 m³/s on Feb 18", days[4])
+	}
+
+	if _, err := client.FetchDischargeHistory(context.Background(), 51.05, 13.74, "1991-01-01", "2020-12-31"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(requestURL, "start_date=1991-01-01&end_date=2020-12-31") {
+		t.Errorf("request URL %q should ask for the date range", requestURL)
+	}
+}
+
+func TestFetchDischargeNoRiver(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"daily":{"time":["2026-02-14","2026-02-15"],"river_discharge":[null,null]}}`))
+	}))
+	defer server.Close()
+
+	client := &FloodClient{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+	}
+
+	if _, err := client.FetchDischarge(context.Background(), 47.42, 10.98, 2); err == nil {
+		t.Error("expected error for a location without a river, got nil")
+	}
+}
+
+func TestFetchHistory(t *testing.T) {
+	fixture, err := os.ReadFile("../../testdata/archive_response.json")
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+
+	var requestURL string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestURL = r.URL.String()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(fixture)
+	}))
+	defer server.Close()
+
+	client := &ArchiveClient{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+	}
+
+	daily, err := client.FetchHistory(context.Background(), 52.52, 13.41, "2024-07-13", "2024-07-15", false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !strings.Contains(requestURL, "start_date=2024-07-13&end_date=2024-07-15") {
+		t.Errorf("request URL %q should contain the date range", requestURL)
+	}
+	// The last day has no observations yet and is skipped
+	if len(daily) != 2 {
+		t.Fatalf("daily count = %d, want 2", len(daily))
+	}
+	if daily[1].Date != "2024-07-14" {
+		t.Errorf("daily[1].date = %q, want %q", daily[1].Date, "2024-07-14")
+	}
+	if daily[1].TemperatureMax != 31.9 {
+		t.Errorf("daily[1].max = %f, want 31.9", daily[1].TemperatureMax)
+	}
+	if daily[1].WeatherCode != 95 {
+		t.Errorf("daily[1].weather_code = %d, want 95", daily[1].WeatherCode)
+	}
+	if daily[1].PrecipitationSum != 12.7 {
+		t.Errorf("daily[1].precipitation_sum = %f, want 12.7", daily[1].PrecipitationSum)
+	}
+}
+
+func TestFetchHistoryNoObservations(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"daily":{"time":["2026-02-13"],"temperature_2m_max":[null],"temperature_2m_min":[null]}}`))
+	}))
+	defer server.Close()
+
+	client := &ArchiveClient{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+	}
+
+	if _, err := client.FetchHistory(context.Background(), 52.52, 13.41, "2026-02-13", "2026-02-13", false); err == nil {
+		t.Error("expected error when no observations are available, got nil")
+	}
+}
+
+func TestFetchModels(t *testing.T) {
+	fixture, err := os.ReadFile("../../testdata/models_response.json")
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+
+	var requestURL string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestURL = r.URL.String()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(fixture)
+	}))
+	defer server.Close()
+
+	client := &Client{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+	}
+
+	models := []string{"icon_seamless", "gfs_seamless", "ecmwf_ifs025"}
+	forecasts, err := client.FetchModels(context.Background(), 52.52, 13.41, 3, false, models)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !strings.Contains(requestURL, "models=icon_seamless,gfs_seamless,ecmwf_ifs025") {
+		t.Errorf("request URL %q should list the models", requestURL)
+	}
+	if len(forecasts) != 3 {
+		t.Fatalf("model count = %d, want 3", len(forecasts))
+	}
+	gfs := forecasts[1]
+	if gfs.Model != "gfs_seamless" || len(gfs.Daily) != 3 {
+		t.Fatalf("gfs = %s with %d days, want gfs_seamless with 3", gfs.Model, len(gfs.Daily))
+	}
+	if gfs.Daily[1].TemperatureMax != 5.6 || gfs.Daily[1].WeatherCode != 63 || gfs.Daily[1].PrecipitationSum != 7.9 {
+		t.Errorf("gfs day 2 = %+v, want max 5.6, code 63, precip 7.9", gfs.Daily[1])
+	}
+	// ECMWF has no data for the last day
+	if len(forecasts[2].Daily) != 2 {
+		t.Errorf("ecmwf day count = %d, want 2", len(forecasts[2].Daily))
+	}
+}
+
+func TestFetchModelsSingle(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"daily":{"time":["2026-02-14"],"temperature_2m_max":[6.2],"temperature_2m_min":[1.3],"weather_code":[3]}}`))
+	}))
+	defer server.Close()
+
+	client := &Client{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+	}
+
+	forecasts, err := client.FetchModels(context.Background(), 52.52, 13.41, 1, false, []string{"icon_seamless"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(forecasts[0].Daily) != 1 || forecasts[0].Daily[0].TemperatureMax != 6.2 {
+		t.Errorf("single model should use unsuffixed fields, got %+v", forecasts[0].Daily)
+	}
+}
+
+func TestResolveModels(t *testing.T) {
+	models, err := ResolveModels("icon, GFS,ecmwf_ifs025")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []string{"icon_seamless", "gfs_seamless", "ecmwf_ifs025"}
+	if strings.Join(models, ",") != strings.Join(want, ",") {
+		t.Errorf("ResolveModels = %v, want %v", models, want)
+	}
+	if _, err := ResolveModels("icon,harmonie"); err == nil {
+		t.Error("expected error for unknown model, got nil")
+	}
+	if _, err := ResolveModels(","); err == nil {
+		t.Error("expected error for empty model list, got nil")
+	}
+}
+
+func TestFetchEnsemble(t *testing.T) {
+	fixture, err := os.ReadFile("../../testdata/ensemble_response.json")
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+
+	var requestURL string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestURL = r.URL.String()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(fixture)
+	}))
+	defer server.Close()
+
+	client := &EnsembleClient{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+	}
+
+	days, err := client.FetchEnsemble(context.Background(), 52.52, 13.41, 2, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !strings.Contains(requestURL, "hourly=temperature_2m,precipitation") {
+		t.Errorf("request URL %q should ask for hourly temperature and precipitation", requestURL)
+	}
+	if len(days) != 2 {
+		t.Fatalf("day count = %d, want 2", len(days))
+	}
+
+	approx := func(name string, got, want float64) {
+		if math.Abs(got-want) > 1e-9 {
+			t.Errorf("%s = %f, want %f", name, got, want)
+		}
+	}
+	// Control run plus four members
+	if days[0].Members != 5 {
+		t.Errorf("day 1 members = %d, want 5", days[0].Members)
+	}
+	approx("day 1 max p10", days[0].TemperatureMax.P10, 4.4)
+	approx("day 1 max p50", days[0].TemperatureMax.P50, 6.0)
+	approx("day 1 max p90", days[0].TemperatureMax.P90, 8.2)
+	approx("day 1 min p50", days[0].TemperatureMin.P50, 1.0)
+	approx("day 1 precip p90", days[0].Precipitation.P90, 1.16)
+	// Member 04 has no data for the second day
+	if days[1].Members != 4 {
+		t.Errorf("day 2 members = %d, want 4", days[1].Members)
+	}
+	approx("day 2 max p50", days[1].TemperatureMax.P50, 3.5)
+	approx("day 2 precip p50", days[1].Precipitation.P50, 2.25)
+
+	// Days beyond the ensemble's range are rejected rather than cut short
+	requestURL = ""
+	if _, err := client.FetchEnsemble(context.Background(), 52.52, 13.41, 10, false); err == nil {
+		t.Error("expected error for 10 days, got nil")
+	}
+	if requestURL != "" {
+		t.Error("no request should be made for more days than the ensemble covers")
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	sorted := []float64{1, 2, 3, 4, 5}
+	tests := []struct {
+		p    float64
+		want float64
+	}{
+		{0, 1},
+		{10, 1.4},
+		{50, 3},
+		{90, 4.6},
+		{100, 5},
+	}
+	for _, tt := range tests {
+		if got := percentile(sorted, tt.p); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("percentile(%v) = %f, want %f", tt.p, got, tt.want)
+		}
+	}
+	if got := percentile(nil, 50); got != 0 {
+		t.Errorf("percentile of no values = %f, want 0", got)
+	}
+}
+
+func TestMetNoFetchWeather(t *testing.T) {
+	fixture, err := os.ReadFile("../../testdata/metno_response.json")
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+
+	var requestURL, userAgent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestURL = r.URL.String()
+		userAgent = r.Header.Get("User-Agent")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(fixture)
+	}))
+	defer server.Close()
+
+	client := &MetNoClient{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+	}
+
+	data, err := client.FetchWeather(context.Background(), 59.9139, 10.7522, 5, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !strings.Contains(requestURL, "lat=59.9139&lon=10.7522") {
+		t.Errorf("request URL %q should contain the coordinates", requestURL)
+	}
+	if strings.Contains(requestURL, "altitude=") {
+		t.Errorf("request URL %q should not set an altitude by default", requestURL)
+	}
+	client.SetElevation(1200)
+	if _, err := client.FetchWeather(context.Background(), 59.9139, 10.7522, 5, false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(requestURL, "altitude=1200") {
+		t.Errorf("request URL %q should contain altitude=1200", requestURL)
+	}
+	if userAgent == "" {
+		t.Error("MET Norway requests must send a User-Agent")
+	}
+	if data.Current.HasApparentTemperature {
+		t.Error("MET Norway reports no apparent temperature")
+	}
+	if data.Current.Temperature != 5.2 {
+		t.Errorf("temperature = %f, want 5.2", data.Current.Temperature)
+	}
+	if data.Current.Humidity != 73 {
+		t.Errorf("humidity = %d, want 73", data.Current.Humidity)
+	}
+	if math.Abs(data.Current.WindSpeed-14.76) > 0.01 {
+		t.Errorf("wind speed = %f, want 14.76 (4.1 m/s in km/h)", data.Current.WindSpeed)
+	}
+	if data.Current.WeatherCode != 3 {
+		t.Errorf("weather_code = %d, want 3", data.Current.WeatherCode)
+	}
+	// Times are UTC in the response, local (UTC+1 in Oslo) in the result
+	if data.Current.Time != "2026-02-14T13:00" {
+		t.Errorf("time = %q, want %q", data.Current.Time, "2026-02-14T13:00")
+	}
+	if data.Timezone != "UTC+1" {
+		t.Errorf("timezone = %q, want %q", data.Timezone, "UTC+1")
+	}
+	if len(data.Daily) != 3 {
+		t.Fatalf("daily count = %d, want 3", len(data.Daily))
+	}
+	if data.Daily[0].TemperatureMax != 6.0 || data.Daily[0].TemperatureMin != 1.5 {
+		t.Errorf("daily[0] max/min = %f/%f, want 6.0/1.5", data.Daily[0].TemperatureMax, data.Daily[0].TemperatureMin)
+	}
+	if math.Abs(data.Daily[0].PrecipitationSum-1.5) > 1e-9 {
+		t.Errorf("daily[0].precipitation = %f, want 1.5 (hourly amounts only)", data.Daily[0].PrecipitationSum)
+	}
+	if data.Daily[0].WeatherCode != 63 {
+		t.Errorf("daily[0].weather_code = %d, want 63", data.Daily[0].WeatherCode)
+	}
+	if math.Abs(data.Daily[1].PrecipitationSum-7.6) > 1e-9 {
+		t.Errorf("daily[1].precipitation = %f, want 7.6 (6-hourly amounts)", data.Daily[1].PrecipitationSum)
+	}
+	if data.Daily[1].WeatherCode != 95 {
+		t.Errorf("daily[1].weather_code = %d, want 95", data.Daily[1].WeatherCode)
+	}
+
+	data, err = client.FetchWeather(context.Background(), 59.9139, 10.7522, 1, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(data.Daily) != 1 {
+		t.Errorf("daily count = %d, want 1", len(data.Daily))
+	}
+	if math.Abs(data.Daily[0].TemperatureMax-42.8) > 1e-9 {
+		t.Errorf("imperial daily[0].max = %f, want 42.8", data.Daily[0].TemperatureMax)
+	}
+
+	// In Tokyo, 20:00 UTC is already the next morning
+	data, err = client.FetchWeather(context.Background(), 35.6762, 139.6503, 5, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if data.Current.Time != "2026-02-14T21:00" || data.Timezone != "UTC+9" {
+		t.Errorf("time = %q in %q, want 2026-02-14T21:00 in UTC+9", data.Current.Time, data.Timezone)
+	}
+	var dates []string
+	for _, d := range data.Daily {
+		dates = append(dates, d.Date)
+	}
+	if strings.Join(dates, ",") != "2026-02-14,2026-02-15,2026-02-16" {
+		t.Errorf("dates = %v, want 2026-02-14 to 2026-02-16", dates)
+	}
+}
+
+func TestMetNoMalformedTime(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"properties":{"timeseries":[` +
+			`{"time":"2026-02-14T12:00:00Z","data":{"instant":{"details":{"air_temperature":5.2}}}},` +
+			`{"time":"2026","data":{"instant":{"details":{"air_temperature":30}}}}]}}`))
+	}))
+	defer server.Close()
+
+	client := &MetNoClient{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+	}
+
+	data, err := client.FetchWeather(context.Background(), 59.9139, 10.7522, 5, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(data.Daily) != 1 || data.Daily[0].TemperatureMax != 5.2 {
+		t.Errorf("daily = %+v, want one day without the malformed entry", data.Daily)
+	}
+}
+
+func TestMoreSevere(t *testing.T) {
+	tests := []struct {
+		a, b int
+		want bool
+	}{
+		{75, 80, true},  // heavy snow over slight rain showers
+		{65, 80, true},  // heavy rain over slight rain showers
+		{82, 81, true},  // violent over moderate showers
+		{95, 67, true},  // thunderstorm over freezing rain
+		{61, 3, true},   // rain over overcast
+		{3, 61, false},  // overcast under rain
+		{0, 42, true},   // unknown codes rank lowest
+		{80, 80, false}, // equal
+	}
+	for _, tt := range tests {
+		if got := moreSevere(tt.a, tt.b); got != tt.want {
+			t.Errorf("moreSevere(%d, %d) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestMetNoWeatherCode(t *testing.T) {
+	tests := []struct {
+		symbol string
+		want   int
+	}{
+		{"clearsky_day", 0},
+		{"fair_polartwilight", 1},
+		{"cloudy", 3},
+		{"rainshowers_night", 81},
+		{"heavysnow", 75},
+		{"lightssnowshowersandthunder_day", 95},
+		{"unknown", 0},
+	}
+	for _, tt := range tests {
+		if got := metnoWeatherCode(tt.symbol); got != tt.want {
+			t.Errorf("metnoWeatherCode(%q) = %d, want %d", tt.symbol, got, tt.want)
+		}
+	}
+}
+
+// nwsServer serves the NWS points and hourly forecast fixtures, pointing the
+// forecast link at the test server itself.
+func nwsServer(t *testing.T) *httptest.Server {
+	points, err := os.ReadFile("../../testdata/nws_points_response.json")
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+	hourly, err := os.ReadFile("../../testdata/nws_hourly_response.json")
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/geo+json")
+		switch r.URL.Path {
+		case "/points/38.8894,-77.0352":
+			w.Write([]byte(strings.ReplaceAll(string(points), "https://api.weather.gov", server.URL)))
+		case "/gridpoints/LWX/97,71/forecast/hourly":
+			w.Write(hourly)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	return server
+}
+
+func TestNWSFetchWeather(t *testing.T) {
+	server := nwsServer(t)
+	defer server.Close()
+
+	client := &NWSClient{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+	}
+
+	data, err := client.FetchWeather(context.Background(), 38.8894, -77.0352, 5, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if data.Timezone != "America/New_York" {
+		t.Errorf("timezone = %q, want %q", data.Timezone, "America/New_York")
+	}
+	if data.Current.Temperature != 41 {
+		t.Errorf("temperature = %f, want 41", data.Current.Temperature)
+	}
+	if data.Current.Humidity != 80 {
+		t.Errorf("humidity = %d, want 80", data.Current.Humidity)
+	}
+	if data.Current.WindSpeed != 5 || data.Current.WindDirection != 315 {
+		t.Errorf("wind = %f mph %d°, want 5 mph 315°", data.Current.WindSpeed, data.Current.WindDirection)
+	}
+	if data.Current.HasApparentTemperature {
+		t.Error("NWS reports no apparent temperature")
+	}
+	if data.Current.WeatherCode != 3 {
+		t.Errorf("weather_code = %d, want 3", data.Current.WeatherCode)
+	}
+	if len(data.Daily) != 2 {
+		t.Fatalf("daily count = %d, want 2", len(data.Daily))
+	}
+	if data.Daily[0].TemperatureMax != 52 || data.Daily[0].TemperatureMin != 38 {
+		t.Errorf("daily[0] max/min = %f/%f, want 52/38", data.Daily[0].TemperatureMax, data.Daily[0].TemperatureMin)
+	}
+	if data.Daily[0].WeatherCode != 95 {
+		t.Errorf("daily[0].weather_code = %d, want 95", data.Daily[0].WeatherCode)
+	}
+	if data.Daily[0].PrecipitationProbability != 60 {
+		t.Errorf("daily[0].precipitation_probability = %d, want 60", data.Daily[0].PrecipitationProbability)
+	}
+	if data.Daily[1].Date != "2026-02-15" || data.Daily[1].WeatherCode != 2 {
+		t.Errorf("daily[1] = %s code %d, want 2026-02-15 code 2", data.Daily[1].Date, data.Daily[1].WeatherCode)
+	}
+
+	data, err = client.FetchWeather(context.Background(), 38.8894, -77.0352, 5, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if data.Current.Temperature != 5 {
+		t.Errorf("metric temperature = %f, want 5", data.Current.Temperature)
+	}
+	if math.Abs(data.Current.WindSpeed-8.05) > 0.01 {
+		t.Errorf("metric wind speed = %f, want 8.05", data.Current.WindSpeed)
+	}
+}
+
+func TestNWSFetchWeatherOutsideUS(t *testing.T) {
+	server := nwsServer(t)
+	defer server.Close()
+
+	client := &NWSClient{
+		HTTPClient: server.Client(),
+		BaseURL:    server.URL,
+	}
+
+	_, err := client.FetchWeather(context.Background(), 52.52, 13.41, 5, false)
+	if err == nil || !strings.Contains(err.Error(), "United States") {
+		t.Errorf("expected an error mentioning the United States, got %v", err)
+	}
+}
+
+func TestNWSWeatherCode(t *testing.T) {
+	tests := []struct {
+		icon string
+		want int
+	}{
+		{"https://api.weather.gov/icons/land/day/skc?size=small", 0},
+		{"https://api.weather.gov/icons/land/night/bkn,20?size=small", 3},
+		{"https://api.weather.gov/icons/land/day/rain_showers,40/tsra,60?size=small", 95},
+		{"https://api.weather.gov/icons/land/day/snow,80", 73},
+		{"", 0},
+	}
+	for _, tt := range tests {
+		if got := nwsWeatherCode(tt.icon); got != tt.want {
+			t.Errorf("nwsWeatherCode(%q) = %d, want %d", tt.icon, got, tt.want)
+		}
+	}
+}
+
+func TestNewProvider(t *testing.T) {
+	for _, name := range ProviderNames {
+		if _, err := NewProvider(name, http.DefaultClient); err != nil {
+			t.Errorf("NewProvider(%q) returned error: %v", name, err)
+		}
+	}
+	if p, _ := NewProvider("", http.DefaultClient); p == nil {
+		t.Error("NewProvider(\"\") should return the default provider")
+	}
+	if _, err := NewProvider("accuweather", http.DefaultClient); err == nil {
+		t.Error("expected error for unknown provider, got nil")
+	}
+	client := &http.Client{}
+	if p, _ := NewProvider("metno", client); p.(*MetNoClient).HTTPClient != client {
+		t.Error("NewProvider should send requests through the given client")
+	}
+	for name, want := range map[string]bool{"open-meteo": true, "metno": true, "nws": false} {
+		p, _ := NewProvider(name, http.DefaultClient)
+		if _, ok := p.(ElevationProvider); ok != want {
+			t.Errorf("provider %q implements ElevationProvider = %v, want %v", name, ok, want)
+		}
+	}
+	for _, name := range ProviderNames {
+		p, _ := NewProvider(name, http.DefaultClient)
+		_, details := p.(DetailsProvider)
+		_, nowcast := p.(NowcastProvider)
+		_, snow := p.(SnowProvider)
+		_, models := p.(ModelsProvider)
+		want := name == "open-meteo"
+		if details != want || nowcast != want || snow != want || models != want {
+			t.Errorf("provider %q implements details/nowcast/snow/models = %v/%v/%v/%v, want %v",
+				name, details, nowcast, snow, models, want)
+		}
+	}
 }
 
 func TestGeocodeCityWithClient(t *testing.T) {
@@ -92,7 +1390,7 @@ func TestGeocodeCityWithClient(t *testing.T) {
 	}))
 	defer server.Close()
 
-	lat, lon, city, country, err := geocodeCityWithClient("Berlin", server.Client(), server.URL)
+	lat, lon, city, country, err := geocodeCityWithClient(context.Background(), "Berlin", server.Client(), server.URL)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -118,7 +1416,7 @@ func TestGeocodeCityNotFound(t *testing.T) {
 	}))
 	defer server.Close()
 
-	_, _, _, _, err := geocodeCityWithClient("Xyzzyville", server.Client(), server.URL)
+	_, _, _, _, err := geocodeCityWithClient(context.Background(), "Xyzzyville", server.Client(), server.URL)
 	if err == nil {
 		t.Error("expected error for unknown city, got nil")
 	}
@@ -3,22 +3,75 @@ package main
 import (
 	"flag"
 	"fmt"
+	"goweather/internal/cache"
+	"goweather/internal/climate"
+	"goweather/internal/degreedays"
 	"goweather/internal/display"
+	"goweather/internal/flood"
 	"goweather/internal/i18n"
 	"goweather/internal/location"
+	"goweather/internal/units"
+	"goweather/internal/warnings"
 	"goweather/internal/weather"
 	"os"
+	"sync"
+	"time"
 )
 
 func main() {
+	// Subcommands parse their own flags
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "solar":
+			runSolar(os.Args[2:])
+			return
+		case "garden":
+			runGarden(os.Args[2:])
+			return
+		case "cache":
+			runCache(os.Args[2:])
+			return
+		}
+	}
+
 	city := flag.String("city", "", "City name for weather lookup")
 	lat := flag.Float64("lat", 0, "Latitude for weather lookup")
 	lon := flag.Float64("lon", 0, "Longitude for weather lookup")
 	imperial := flag.Bool("imperial", false, "Use imperial units (Fahrenheit, mph)")
 	metric := flag.Bool("metric", false, "Use metric units (Celsius, km/h) [default]")
 	noColor := flag.Bool("no-color", false, "Disable ANSI color codes in output")
-	days := flag.Int("days", 5, "Number of forecast days (1-7)")
+	noCache := flag.Bool("no-cache", false, "Always fetch fresh data instead of using cached responses")
+	offline := flag.Bool("offline", false, "Show the last cached forecast without using the network")
+	timeout := flag.Duration("timeout", defaultTimeout, "Time limit for fetching data, e.g. 10s or 1m")
+	days := flag.Int("days", 5, "Number of forecast days (1-16)")
+	pastDays := flag.Int("past-days", 0, "Number of recent past days to show before the forecast (0-7)")
 	lang := flag.String("lang", "", "Language (en, de, es, fr, it, zh)")
+	hourly := flag.Bool("hourly", false, "Show the hourly forecast instead of the daily forecast")
+	hours := flag.Int("hours", 12, "Number of hours for --hourly (1-48)")
+	details := flag.Bool("details", false, "Show pressure with its 3h trend, dew point, cloud cover, visibility and gusts")
+	air := flag.Bool("air", false, "Show current air quality (AQI, PM2.5, PM10, ozone, NO2)")
+	pollen := flag.Bool("pollen", false, "Show the daily pollen forecast (Europe only)")
+	anomaly := flag.Bool("anomaly", false, "Compare temperatures with the 1991-2020 climate normal (first run per location downloads the climate record)")
+	marine := flag.Bool("marine", false, "Show the marine forecast (waves, swell, sea temperature) instead of the daily forecast")
+	date := flag.String("date", "", "Show observed weather for a past date (YYYY-MM-DD)")
+	from := flag.String("from", "", "Start of a past date range (YYYY-MM-DD), used with --to")
+	to := flag.String("to", "", "End of a past date range (YYYY-MM-DD), used with --from")
+	floodOutlook := flag.Bool("flood", false, "Show the river discharge forecast and flood risk against the 1991-2020 record instead of the daily forecast")
+	snow := flag.Bool("snow", false, "Show new snow per day, snow depth and freezing level instead of the daily forecast")
+	nowcast := flag.Bool("nowcast", false, "Show precipitation for the next 2 hours in 15-minute steps")
+	ensemble := flag.Bool("ensemble", false, "Show forecast uncertainty (10th-90th percentile ranges across ensemble members)")
+	modelList := flag.String("models", "", "Compare weather models side by side, e.g. icon,gfs,ecmwf (also meteofrance, jma)")
+	elevation := flag.Float64("elevation", 0, "Forecast for this elevation, e.g. a summit, in meters (feet with --imperial) instead of the terrain height")
+	provider := flag.String("provider", "open-meteo", "Weather provider (open-meteo, metno, nws)")
+	degreeDays := flag.Bool("degree-days", false, "Show heating, cooling and growing degree days with weekly totals (also for --date/--from/--to)")
+	hddBase := flag.Float64("hdd-base", 0, "Base temperature for heating degree days (default 18°C / 65°F)")
+	cddBase := flag.Float64("cdd-base", 0, "Base temperature for cooling degree days (default 18°C / 65°F)")
+	gddBase := flag.Float64("gdd-base", 0, "Crop base temperature for growing degree days (default 10°C / 50°F)")
+	gddCap := flag.Float64("gdd-cap", 0, "Crop cap temperature for growing degree days (default 30°C / 86°F)")
+	warnHeat := flag.Float64("warn-heat", 0, "Heat warning threshold for the daily max (default 30°C / 86°F)")
+	warnFrost := flag.Float64("warn-frost", 0, "Frost warning threshold for the daily min (default -10°C / 14°F)")
+	warnGust := flag.Float64("warn-gust", 0, "Storm gust warning threshold (default 75 km/h / 47 mph)")
+	warnPrecip := flag.Float64("warn-precip", 0, "Heavy precipitation warning threshold per day (default 30mm / 1.2in)")
 	flag.Parse()
 
 	// Initialize i18n (before any output)
@@ -28,8 +81,111 @@ func main() {
 	_ = metric
 
 	// Validate --days range
-	if *days < 1 || *days > 7 {
-		fmt.Fprintf(os.Stderr, "Error: --days must be between 1 and 7 (got %d)\n", *days)
+	if *days < 1 || *days > 16 {
+		fmt.Fprintf(os.Stderr, "Error: --days must be between 1 and 16 (got %d)\n", *days)
+		os.Exit(1)
+	}
+
+	// Validate --past-days range
+	if *pastDays < 0 || *pastDays > 7 {
+		fmt.Fprintf(os.Stderr, "Error: --past-days must be between 0 and 7 (got %d)\n", *pastDays)
+		os.Exit(1)
+	}
+
+	// Validate --hours range
+	if *hours < 1 || *hours > 48 {
+		fmt.Fprintf(os.Stderr, "Error: --hours must be between 1 and 48 (got %d)\n", *hours)
+		os.Exit(1)
+	}
+
+	if *timeout <= 0 {
+		fmt.Fprintf(os.Stderr, "Error: --timeout must be positive (got %s)\n", *timeout)
+		os.Exit(1)
+	}
+
+	// Only one alternative view can be shown at a time
+	views := 0
+	for _, set := range []bool{*hourly, *marine, *modelList != "", *ensemble, *nowcast, *snow, *degreeDays, *floodOutlook} {
+		if set {
+			views++
+		}
+	}
+	if views > 1 {
+		fmt.Fprintln(os.Stderr, "Error: --hourly, --marine, --models, --ensemble, --nowcast, --snow, --degree-days and --flood cannot be combined")
+		os.Exit(1)
+	}
+
+	if *ensemble && *days > weather.EnsembleMaxDays {
+		fmt.Fprintf(os.Stderr, "Error: --ensemble covers at most %d days (got --days %d)\n", weather.EnsembleMaxDays, *days)
+		os.Exit(1)
+	}
+
+	if *offline && *noCache {
+		fmt.Fprintln(os.Stderr, "Error: --offline and --no-cache cannot be combined")
+		os.Exit(1)
+	}
+	// Offline, the last saved forecast is all there is to show
+	if *offline && (views > 0 || *date != "" || *from != "" || *to != "") {
+		fmt.Fprintln(os.Stderr, "Error: --offline only shows the daily forecast")
+		os.Exit(1)
+	}
+	if *offline && (*air || *pollen || *anomaly) {
+		fmt.Fprintln(os.Stderr, "Error: --air, --pollen and --anomaly need the network and cannot be combined with --offline")
+		os.Exit(1)
+	}
+
+	// Retry transient failures and serve repeated requests from the on-disk cache
+	httpClient := newHTTPClient(!*noCache, *offline)
+
+	// Validate --provider
+	forecastProvider, err := weather.NewProvider(*provider, httpClient)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	hourlyProvider, hasHourly := forecastProvider.(weather.HourlyProvider)
+	if *hourly && !hasHourly {
+		fmt.Fprintf(os.Stderr, "Error: --hourly is not supported by provider %q\n", *provider)
+		os.Exit(1)
+	}
+	pastProvider, hasPast := forecastProvider.(weather.PastProvider)
+	if *pastDays > 0 && !hasPast {
+		fmt.Fprintf(os.Stderr, "Error: --past-days is not supported by provider %q\n", *provider)
+		os.Exit(1)
+	}
+	if _, ok := forecastProvider.(weather.DetailsProvider); *details && !ok {
+		fmt.Fprintf(os.Stderr, "Error: --details is not supported by provider %q\n", *provider)
+		os.Exit(1)
+	}
+	nowcastProvider, hasNowcast := forecastProvider.(weather.NowcastProvider)
+	if *nowcast && !hasNowcast {
+		fmt.Fprintf(os.Stderr, "Error: --nowcast is not supported by provider %q\n", *provider)
+		os.Exit(1)
+	}
+	snowProvider, hasSnow := forecastProvider.(weather.SnowProvider)
+	if *snow && !hasSnow {
+		fmt.Fprintf(os.Stderr, "Error: --snow is not supported by provider %q\n", *provider)
+		os.Exit(1)
+	}
+	modelsProvider, hasModels := forecastProvider.(weather.ModelsProvider)
+
+	// Validate --models
+	var models []string
+	if *modelList != "" {
+		if !hasModels {
+			fmt.Fprintf(os.Stderr, "Error: --models is not supported by provider %q\n", *provider)
+			os.Exit(1)
+		}
+		if models, err = weather.ResolveModels(*modelList); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
+	// Validate --date / --from / --to
+	histFrom, histTo, err := parseDateRange(*date, *from, *to)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 		os.Exit(1)
 	}
 
@@ -39,53 +195,406 @@ func main() {
 		os.Exit(1)
 	}
 
-	// Apply color setting
+	// Warning thresholds and degree-day bases: unit-system defaults,
+	// overridden by explicitly set flags
+	thresholds := warnings.DefaultThresholds(*imperial)
+	bases := degreedays.DefaultBases(*imperial)
+	flag.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "hdd-base":
+			bases.Heating = *hddBase
+		case "cdd-base":
+			bases.Cooling = *cddBase
+		case "gdd-base":
+			bases.Growing = *gddBase
+		case "gdd-cap":
+			bases.GrowingCap = *gddCap
+		case "warn-heat":
+			thresholds.Heat = *warnHeat
+		case "warn-frost":
+			thresholds.Frost = *warnFrost
+		case "warn-gust":
+			thresholds.Gust = *warnGust
+		case "warn-precip":
+			thresholds.Precipitation = *warnPrecip
+		}
+	})
+	if bases.GrowingCap <= bases.Growing {
+		fmt.Fprintf(os.Stderr, "Error: --gdd-cap must be above --gdd-base (got %g and %g)\n", bases.GrowingCap, bases.Growing)
+		os.Exit(1)
+	}
+
+	// Validate --elevation: entered in the active unit system, applied to the
+	// forecast provider in meters
+	var forecastElevation *float64
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name == "elevation" {
+			m := units.ElevationToMeters(*elevation, *imperial)
+			forecastElevation = &m
+		}
+	})
+	if forecastElevation != nil {
+		ep, ok := forecastProvider.(weather.ElevationProvider)
+		if !ok {
+			fmt.Fprintf(os.Stderr, "Error: --elevation is not supported by provider %q\n", *provider)
+			os.Exit(1)
+		}
+		ep.SetElevation(*forecastElevation)
+	}
+
+	// Apply display settings
 	display.ColorEnabled = !*noColor
+	display.ShowDetails = *details
 
-	// Wire up geocoding function to avoid circular imports
-	location.GeocodeFunc = weather.GeocodeCity
+	// Look up cities through the same client
+	useGeocoder(httpClient)
 
 	cfg := location.Config{
-		City:      *city,
-		Latitude:  *lat,
-		Longitude: *lon,
-		Imperial:  *imperial,
-		NoColor:   *noColor,
-		Days:      *days,
+		City:       *city,
+		Latitude:   *lat,
+		Longitude:  *lon,
+		Imperial:   *imperial,
+		NoColor:    *noColor,
+		Days:       *days,
+		PastDays:   *pastDays,
+		Hourly:     *hourly,
+		Hours:      *hours,
+		Details:    *details,
+		Elevation:  forecastElevation,
+		Air:        *air,
+		Pollen:     *pollen,
+		Marine:     *marine,
+		Snow:       *snow,
+		DegreeDays: *degreeDays,
+		Flood:      *floodOutlook,
+		Models:     models,
+		Ensemble:   *ensemble,
+		Nowcast:    *nowcast,
+		Anomaly:    *anomaly,
+		Provider:   *provider,
+		Offline:    *offline,
+		From:       histFrom,
+		To:         histTo,
+		HTTPClient: httpClient,
 	}
 
+	// Ctrl-C or the time limit cancels all requests
+	ctx, cancel := newContext(*timeout)
+	defer cancel()
+
 	// Resolve location
-	loc, err := location.ResolveLocation(cfg)
+	loc, err := location.ResolveLocation(ctx, cfg)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-		if loc.Source == "" {
-			fmt.Fprintln(os.Stderr, i18n.TipManualLocation())
+		exitIfInterrupted(ctx)
+		// Without a network, a location found before still has its last forecast
+		if !cfg.Offline && renderStaleFallback(cfg, err) {
+			return
 		}
-		os.Exit(1)
+		exitLocationError(ctx, loc, err)
+	}
+
+	locName := locationName(loc)
+
+	// Offline, the last forecast saved for the location is all there is
+	if cfg.Offline {
+		cached, asOf, err := cache.LoadWeather(loc.Latitude, loc.Longitude, cfg.Imperial)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "Error: No cached forecast for this location")
+			os.Exit(1)
+		}
+		renderStale(locName, cached, asOf, cfg)
+		return
+	}
+
+	archiveClient := weather.NewArchiveClient()
+	archiveClient.HTTPClient = httpClient
+
+	// Historical lookup replaces the forecast entirely
+	if cfg.From != "" {
+		daily, err := archiveClient.FetchHistory(ctx, loc.Latitude, loc.Longitude, cfg.From, cfg.To, cfg.Imperial)
+		if err != nil {
+			exitIfInterrupted(ctx)
+			fmt.Fprintf(os.Stderr, "Error: Unable to fetch historical weather data: %v\n", err)
+			os.Exit(1)
+		}
+		if cfg.DegreeDays {
+			fmt.Print(display.RenderDegreeDaysCard(locName, degreedays.Compute(daily, bases), bases, cfg.Imperial))
+			return
+		}
+		fmt.Print(display.RenderHistoryCard(locName, daily, cfg.Imperial))
+		return
+	}
+
+	// Fetch air quality, pollen, marine, snow and flood data concurrently with the forecast
+	aqClient := weather.NewAirQualityClient()
+	aqClient.HTTPClient = httpClient
+	var airQuality *weather.AirQuality
+	var pollenForecast *weather.PollenForecast
+	var marineData *weather.MarineData
+	var snowData *weather.SnowData
+	var discharge []weather.DailyDischarge
+	var dischargeStats *flood.Stats
+	var modelForecasts []weather.ModelForecast
+	var ensembleDays []weather.DailyEnsemble
+	var nowcastData *weather.Nowcast
+	var terrainElevation float64
+	var normals *climate.Normals
+	var airErr, pollenErr, marineErr, snowErr, dischargeErr, dischargeStatsErr, modelsErr, ensembleErr, nowcastErr, elevationErr, normalsErr error
+	var wg sync.WaitGroup
+	recordCtx, cancelRecord := newRecordContext(*timeout)
+	defer cancelRecord()
+	if cfg.Air {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			airQuality, airErr = aqClient.FetchAirQuality(ctx, loc.Latitude, loc.Longitude)
+		}()
+	}
+	if cfg.Pollen {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			pollenForecast, pollenErr = aqClient.FetchPollen(ctx, loc.Latitude, loc.Longitude, cfg.Days)
+		}()
+	}
+	if cfg.Anomaly {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			normals, normalsErr = climate.Get(recordCtx, archiveClient, loc.Latitude, loc.Longitude)
+		}()
+	}
+	if cfg.Marine {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			marineClient := weather.NewMarineClient()
+			marineClient.HTTPClient = httpClient
+			marineData, marineErr = marineClient.FetchMarine(ctx, loc.Latitude, loc.Longitude, cfg.Days)
+		}()
+	}
+	if cfg.Snow {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			snowData, snowErr = snowProvider.FetchSnow(ctx, loc.Latitude, loc.Longitude, cfg.Days)
+		}()
+	}
+	if cfg.Flood {
+		floodClient := weather.NewFloodClient()
+		floodClient.HTTPClient = httpClient
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			discharge, dischargeErr = floodClient.FetchDischarge(ctx, loc.Latitude, loc.Longitude, cfg.Days)
+		}()
+		go func() {
+			defer wg.Done()
+			dischargeStats, dischargeStatsErr = flood.Get(recordCtx, floodClient, loc.Latitude, loc.Longitude)
+		}()
+	}
+	if len(cfg.Models) > 0 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			modelForecasts, modelsErr = modelsProvider.FetchModels(ctx, loc.Latitude, loc.Longitude, cfg.Days, cfg.Imperial, cfg.Models)
+		}()
+	}
+	if cfg.Ensemble {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ensembleClient := weather.NewEnsembleClient()
+			ensembleClient.HTTPClient = httpClient
+			if cfg.Elevation != nil {
+				ensembleClient.SetElevation(*cfg.Elevation)
+			}
+			ensembleDays, ensembleErr = ensembleClient.FetchEnsemble(ctx, loc.Latitude, loc.Longitude, cfg.Days, cfg.Imperial)
+		}()
+	}
+
+	// The nowcast also feeds the rain summary on the default card; other
+	// views never show it
+	if hasNowcast && (cfg.Nowcast || views == 0) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			nowcastData, nowcastErr = nowcastProvider.FetchNowcast(ctx, loc.Latitude, loc.Longitude, cfg.Imperial)
+		}()
+	}
+	// Terrain elevation for the card header, unless an explicit one was given
+	if cfg.Elevation == nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			elevationClient := weather.NewElevationClient()
+			elevationClient.HTTPClient = httpClient
+			terrainElevation, elevationErr = elevationClient.FetchElevation(ctx, loc.Latitude, loc.Longitude)
+		}()
 	}
 
 	// Fetch weather
-	client := weather.NewClient()
-	data, err := client.FetchWeather(loc.Latitude, loc.Longitude, cfg.Days, cfg.Imperial)
+	var data *weather.WeatherData
+	if cfg.PastDays > 0 {
+		data, err = pastProvider.FetchWeatherWithPast(ctx, loc.Latitude, loc.Longitude, cfg.PastDays, cfg.Days, cfg.Imperial)
+	} else {
+		data, err = forecastProvider.FetchWeather(ctx, loc.Latitude, loc.Longitude, cfg.Days, cfg.Imperial)
+	}
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error: Unable to fetch weather data: %v\n", err)
+		exitIfInterrupted(ctx)
+
+		// Fall back to the last forecast, e.g. when the network is down
+		cached, asOf, cacheErr := cache.LoadWeather(loc.Latitude, loc.Longitude, cfg.Imperial)
+		if cacheErr != nil {
+			fmt.Fprintf(os.Stderr, "Error: Unable to fetch weather data: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Fprintf(os.Stderr, "Warning: Unable to fetch weather data, showing the last forecast: %v\n", err)
+		renderStale(locName, cached, asOf, cfg)
+		return
+	}
+
+	if cfg.Hourly {
+		data.Hourly, err = hourlyProvider.FetchHourly(ctx, loc.Latitude, loc.Longitude, cfg.Hours, cfg.Imperial)
+		if err != nil {
+			exitIfInterrupted(ctx)
+			fmt.Fprintf(os.Stderr, "Error: Unable to fetch hourly forecast: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
+	wg.Wait()
+	exitIfInterrupted(ctx)
+	if airErr != nil {
+		fmt.Fprintf(os.Stderr, "Warning: Unable to fetch air quality data: %v\n", airErr)
+	}
+	if pollenErr != nil {
+		fmt.Fprintf(os.Stderr, "Warning: Unable to fetch pollen data: %v\n", pollenErr)
+	}
+	if modelsErr != nil {
+		fmt.Fprintf(os.Stderr, "Error: Unable to fetch model forecasts: %v\n", modelsErr)
+		os.Exit(1)
+	}
+	if ensembleErr != nil {
+		fmt.Fprintf(os.Stderr, "Error: Unable to fetch ensemble forecast: %v\n", ensembleErr)
+		os.Exit(1)
+	}
+	if snowErr != nil {
+		fmt.Fprintf(os.Stderr, "Error: Unable to fetch snow data: %v\n", snowErr)
 		os.Exit(1)
 	}
+	if dischargeErr != nil {
+		fmt.Fprintf(os.Stderr, "Error: Unable to fetch river discharge forecast: %v\n", dischargeErr)
+		os.Exit(1)
+	}
+	if dischargeStatsErr != nil {
+		fmt.Fprintf(os.Stderr, "Error: Unable to compute river discharge statistics: %v\n", dischargeStatsErr)
+		os.Exit(1)
+	}
+	if nowcastErr != nil && cfg.Nowcast {
+		fmt.Fprintf(os.Stderr, "Error: Unable to fetch nowcast: %v\n", nowcastErr)
+		os.Exit(1)
+	}
+	if marineErr != nil {
+		fmt.Fprintf(os.Stderr, "Error: Unable to fetch marine data: %v\n", marineErr)
+		os.Exit(1)
+	}
+	if normalsErr != nil {
+		fmt.Fprintf(os.Stderr, "Warning: Unable to compute climate normals: %v\n", normalsErr)
+	}
+	if normals != nil {
+		climate.Apply(data.Daily, normals, cfg.Imperial)
+	}
+	data.AirQuality = airQuality
+	data.Pollen = pollenForecast
+	data.Marine = marineData
+	data.Snow = snowData
+	data.Nowcast = nowcastData
+	if cfg.Elevation != nil {
+		data.Elevation, data.HasElevation = *cfg.Elevation, true
+	} else if elevationErr == nil {
+		data.Elevation, data.HasElevation = terrainElevation, true
+	}
+	data.Warnings = warnings.Evaluate(data, thresholds)
+
+	// Keep the forecast for offline use
+	_ = cache.SaveWeather(loc.Latitude, loc.Longitude, cfg.Imperial, data)
+
+	// Render and print
+	var output string
+	switch {
+	case cfg.Hourly:
+		output = display.RenderHourlyCard(locName, data, cfg.Imperial, cfg.Hours)
+	case cfg.Marine:
+		output = display.RenderMarineCard(locName, data, cfg.Imperial, cfg.Days)
+	case cfg.Snow:
+		output = display.RenderSnowCard(locName, data, cfg.Imperial, cfg.Days)
+	case cfg.Flood:
+		output = display.RenderFloodCard(locName, data, flood.Outlook(discharge, dischargeStats), cfg.Imperial)
+	case cfg.DegreeDays:
+		daily := data.Daily
+		if n := data.PastDays + cfg.Days; n < len(daily) {
+			daily = daily[:n]
+		}
+		output = display.RenderDegreeDaysCard(locName, degreedays.Compute(daily, bases), bases, cfg.Imperial)
+	case len(cfg.Models) > 0:
+		output = display.RenderModelsCard(locName, data, modelForecasts, cfg.Imperial, cfg.Days)
+	case cfg.Ensemble:
+		output = display.RenderEnsembleCard(locName, data, ensembleDays, cfg.Imperial, cfg.Days)
+	case cfg.Nowcast:
+		output = display.RenderNowcastCard(locName, data, cfg.Imperial)
+	default:
+		output = display.RenderWeatherCard(locName, data, cfg.Imperial, cfg.Days)
+	}
+	fmt.Print(output)
+}
 
-	// Build location display name
-	locName := loc.City
+// locationName builds the display name of a location: city and country, or
+// the coordinates when neither is known.
+func locationName(loc location.Location) string {
+	name := loc.City
 	if loc.Country != "" {
-		if locName != "" {
-			locName += ", " + loc.Country
+		if name != "" {
+			name += ", " + loc.Country
 		} else {
-			locName = loc.Country
+			name = loc.Country
 		}
 	}
-	if locName == "" {
-		locName = fmt.Sprintf("%.2f, %.2f", loc.Latitude, loc.Longitude)
+	if name == "" {
+		name = fmt.Sprintf("%.2f, %.2f", loc.Latitude, loc.Longitude)
 	}
+	return name
+}
 
-	// Render and print
-	output := display.RenderWeatherCard(locName, data, cfg.Imperial, cfg.Days)
-	fmt.Print(output)
+// parseDateRange validates the --date and --from/--to flags and returns the
+// inclusive range to look up, or empty strings when no historical lookup was requested.
+func parseDateRange(date, from, to string) (string, string, error) {
+	if date != "" {
+		if from != "" || to != "" {
+			return "", "", fmt.Errorf("--date cannot be combined with --from/--to")
+		}
+		from, to = date, date
+	}
+	if from == "" && to == "" {
+		return "", "", nil
+	}
+	if from == "" || to == "" {
+		return "", "", fmt.Errorf("both --from and --to must be provided together")
+	}
+
+	start, err := time.Parse("2006-01-02", from)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid date %q (expected YYYY-MM-DD)", from)
+	}
+	end, err := time.Parse("2006-01-02", to)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid date %q (expected YYYY-MM-DD)", to)
+	}
+	if end.Before(start) {
+		return "", "", fmt.Errorf("--from must not be after --to")
+	}
+	if !end.Before(time.Now()) {
+		return "", "", fmt.Errorf("historical dates must be in the past (got %s)", to)
+	}
+	return from, to, nil
 }
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"goweather/internal/cache"
+	"goweather/internal/retry"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+)
+
+// defaultTimeout bounds all network requests of a run unless --timeout says
+// otherwise.
+const defaultTimeout = 30 * time.Second
+
+// recordTimeout is the least time given to downloading a 30-year record for
+// climate normals or discharge statistics, which happens once per location.
+const recordTimeout = 2 * time.Minute
+
+// newContext returns the context for a run's network requests. It is done
+// once timeout has passed or when the user presses Ctrl-C.
+func newContext(timeout time.Duration) (context.Context, context.CancelFunc) {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	return ctx, func() {
+		cancel()
+		stop()
+	}
+}
+
+// newRecordContext returns the context for downloading a long record, which
+// gets at least recordTimeout. Ctrl-C cancels it like the run's context.
+func newRecordContext(timeout time.Duration) (context.Context, context.CancelFunc) {
+	return newContext(max(timeout, recordTimeout))
+}
+
+// newHTTPClient returns the client for all requests of a run. It retries
+// requests that failed for transient reasons and, with useCache, serves
+// repeated requests from the on-disk cache, which answers offline requests
+// on its own. The cache sits in front so that cached responses skip the
+// retries.
+func newHTTPClient(useCache, offline bool) *http.Client {
+	var transport http.RoundTripper = retry.NewTransport(http.DefaultTransport)
+	if useCache {
+		transport = &cache.Transport{Base: transport, Offline: offline}
+	}
+	return &http.Client{Transport: transport}
+}
+
+// exitIfInterrupted exits quietly after Ctrl-C, instead of reporting the
+// requests it aborted as errors.
+func exitIfInterrupted(ctx context.Context) {
+	if errors.Is(ctx.Err(), context.Canceled) {
+		os.Exit(130)
+	}
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"goweather/internal/cache"
+	"goweather/internal/display"
+	"goweather/internal/location"
+	"goweather/internal/weather"
+	"os"
+	"time"
+)
+
+// renderStale prints a forecast from the cache with a banner giving the time
+// it was fetched.
+func renderStale(locName string, data *weather.WeatherData, asOf time.Time, cfg location.Config) {
+	markPastDays(data, time.Now().Format("2006-01-02"))
+
+	display.StaleAsOf = asOf
+	fmt.Print(display.RenderWeatherCard(locName, data, cfg.Imperial, cfg.Days))
+}
+
+// renderStaleFallback shows the last forecast when the location could not be
+// resolved, e.g. because the network is down and the city lookup is no longer
+// fresh in the cache. It resolves the location from cached data alone and
+// reports whether there was a forecast to show.
+func renderStaleFallback(cfg location.Config, cause error) bool {
+	offlineClient := newHTTPClient(true, true)
+	useGeocoder(offlineClient)
+	cfg.Offline = true
+	cfg.HTTPClient = offlineClient
+
+	// Nothing goes over the network, so the run's deadline does not apply
+	loc, err := location.ResolveLocation(context.Background(), cfg)
+	if err != nil {
+		return false
+	}
+	cached, asOf, err := cache.LoadWeather(loc.Latitude, loc.Longitude, cfg.Imperial)
+	if err != nil {
+		return false
+	}
+	fmt.Fprintf(os.Stderr, "Warning: Unable to find the location, showing the last forecast: %v\n", cause)
+	renderStale(locationName(loc), cached, asOf, cfg)
+	return true
+}
+
+// markPastDays turns forecast days before today (YYYY-MM-DD) into past days,
+// so that an old forecast starts at today and shows the days since dimmed.
+func markPastDays(data *weather.WeatherData, today string) {
+	for data.PastDays < len(data.Daily) && data.Daily[data.PastDays].Date < today {
+		data.PastDays++
+	}
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"goweather/internal/config"
+	"goweather/internal/display"
+	"goweather/internal/solar"
+	"os"
+)
+
+// solarExample is a config file snippet shown when no panel is configured.
+const solarExample = `{"solar": {"kwp": 6.5, "tilt": 35, "azimuth": 180, "losses": 14}}`
+
+// runSolar implements "weather solar": the estimated daily energy yield and
+// today's output curve for the PV panel configured in the config file.
+func runSolar(args []string) {
+	fs := flag.NewFlagSet("solar", flag.ExitOnError)
+	sub := newSubcommand(fs)
+	fs.Parse(args)
+
+	// Panel settings come from the config file
+	settings, err := config.Load()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	panel := settings.Solar
+	if panel == nil {
+		fmt.Fprintf(os.Stderr, "Error: No solar panel configured. Add a \"solar\" section to %s, e.g.\n  %s\n", config.Path, solarExample)
+		os.Exit(1)
+	}
+	if err := panel.Validate(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: Invalid solar panel in %s: %v\n", config.Path, err)
+		os.Exit(1)
+	}
+
+	loc, client, ctx, cancel := sub.resolve()
+	defer cancel()
+
+	radiation, err := client.FetchRadiation(ctx, loc.Latitude, loc.Longitude, *sub.days)
+	if err != nil {
+		exitIfInterrupted(ctx)
+		fmt.Fprintf(os.Stderr, "Error: Unable to fetch radiation forecast: %v\n", err)
+		os.Exit(1)
+	}
+
+	hourly, daily := solar.Estimate(radiation, loc.Latitude, loc.Longitude, *panel)
+	fmt.Print(display.RenderSolarCard(locationName(loc), *panel, hourly, daily))
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"goweather/internal/display"
+	"goweather/internal/i18n"
+	"goweather/internal/location"
+	"goweather/internal/weather"
+	"net/http"
+	"os"
+	"time"
+)
+
+// subcommand holds the flags shared by the subcommands, which each show one
+// card for a location.
+type subcommand struct {
+	city    *string
+	lat     *float64
+	lon     *float64
+	days    *int
+	lang    *string
+	noColor *bool
+	noCache *bool
+	timeout *time.Duration
+}
+
+// newSubcommand defines the shared flags on fs.
+func newSubcommand(fs *flag.FlagSet) *subcommand {
+	return &subcommand{
+		city:    fs.String("city", "", "City name for weather lookup"),
+		lat:     fs.Float64("lat", 0, "Latitude for weather lookup"),
+		lon:     fs.Float64("lon", 0, "Longitude for weather lookup"),
+		days:    fs.Int("days", 7, "Number of forecast days (1-16)"),
+		lang:    fs.String("lang", "", "Language (en, de, es, fr, it, zh)"),
+		noColor: fs.Bool("no-color", false, "Disable ANSI color codes in output"),
+		noCache: fs.Bool("no-cache", false, "Always fetch fresh data instead of using cached responses"),
+		timeout: fs.Duration("timeout", defaultTimeout, "Time limit for fetching data, e.g. 10s or 1m"),
+	}
+}
+
+// resolve validates the shared flags after parsing and resolves the location.
+// It returns an Open-Meteo client and the context for the subcommand's
+// requests, and exits on errors.
+func (s *subcommand) resolve() (location.Location, *weather.Client, context.Context, context.CancelFunc) {
+	i18n.Init(*s.lang)
+
+	if *s.days < 1 || *s.days > 16 {
+		fmt.Fprintf(os.Stderr, "Error: --days must be between 1 and 16 (got %d)\n", *s.days)
+		os.Exit(1)
+	}
+	if *s.timeout <= 0 {
+		fmt.Fprintf(os.Stderr, "Error: --timeout must be positive (got %s)\n", *s.timeout)
+		os.Exit(1)
+	}
+	if (*s.lat != 0 && *s.lon == 0) || (*s.lat == 0 && *s.lon != 0) {
+		fmt.Fprintln(os.Stderr, "Error: Both --lat and --lon must be provided together")
+		os.Exit(1)
+	}
+
+	httpClient := newHTTPClient(!*s.noCache, false)
+	useGeocoder(httpClient)
+	display.ColorEnabled = !*s.noColor
+
+	ctx, cancel := newContext(*s.timeout)
+	loc, err := location.ResolveLocation(ctx, location.Config{City: *s.city, Latitude: *s.lat, Longitude: *s.lon, HTTPClient: httpClient})
+	if err != nil {
+		exitLocationError(ctx, loc, err)
+	}
+
+	client := weather.NewClient()
+	client.HTTPClient = httpClient
+	return loc, client, ctx, cancel
+}
+
+// useGeocoder wires up city lookups through client; the location package
+// cannot import the weather package itself.
+func useGeocoder(client *http.Client) {
+	geocoder := weather.NewGeocodingClient()
+	geocoder.HTTPClient = client
+	location.GeocodeFunc = geocoder.GeocodeCity
+}
+
+// exitLocationError reports a location that could not be resolved, with a
+// tip to set one manually unless the location was found but failed later.
+func exitLocationError(ctx context.Context, loc location.Location, err error) {
+	exitIfInterrupted(ctx)
+	fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+	if loc.Source == "" {
+		fmt.Fprintln(os.Stderr, i18n.TipManualLocation())
+	}
+	os.Exit(1)
+}